@@ -0,0 +1,225 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden file lists in testdata/golden")
+
+// checkCase is a representative configuration generated by the template
+// tests. Its file list is recorded in testdata/golden/<name>.golden.
+type checkCase struct {
+	name   string
+	config ProjectConfig
+}
+
+// checkOpenAPIDocument is the OpenAPI document of the openapi cases: a GET
+// and a POST with path and query parameters, a shared and an inline schema
+const checkOpenAPIDocument = `
+openapi: 3.0.3
+info:
+  title: Orders
+paths:
+  /orders:
+    get:
+      operationId: listOrders
+      parameters:
+        - {name: status, in: query, schema: {type: string}}
+      responses:
+        "200":
+          content:
+            application/json:
+              schema: {type: array, items: {$ref: "#/components/schemas/Order"}}
+    post:
+      operationId: createOrder
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [item]
+              properties:
+                item: {type: string}
+                quantity: {type: integer}
+      responses:
+        "201":
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/Order"}
+  /orders/{id}/cancel:
+    post:
+      responses:
+        "204": {description: canceled}
+components:
+  schemas:
+    Order:
+      type: object
+      required: [id]
+      properties:
+        id: {type: integer, format: int64}
+        item: {type: string}
+        tags: {type: array, items: {type: string}}
+`
+
+// parseCheckOpenAPI returns checkOpenAPIDocument parsed
+func parseCheckOpenAPI(t *testing.T) *APISpec {
+	t.Helper()
+	spec, err := ParseOpenAPI([]byte(checkOpenAPIDocument))
+	if err != nil {
+		t.Fatalf("parse OpenAPI fixture: %v", err)
+	}
+	return spec
+}
+
+// checkCases covers every structure with its main project types and a spread
+// of routers, loggers and features. Add a case here when a layout or project
+// type is added, then run the test with -update to record its golden list.
+func checkCases(t *testing.T) []checkCase {
+	spec := parseCheckOpenAPI(t)
+	return []checkCase{
+		{"standard-rest-chi", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "chi", Logger: "zerolog",
+			UseDocker: true, UseGitHub: true, UseConfig: true, UseLogger: true, UseDatabase: true, UseRedis: true, UseJWT: true,
+			UseAir: true, UseLinter: true, UseKubernetes: true, UseSqlc: true, UseSwagger: true, UseDevTooling: true, License: "mit",
+			UseDependencyBot: "dependabot", Dependencies: []string{prometheusPackage, openTelemetryPackage, testifyPackage}}},
+		{"standard-rest-stdlib", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "stdlib", Logger: "slog",
+			UseLogger: true, UseDatabase: true, Database: "sqlite", CIProvider: "gitlab", UseSBOM: true}},
+		{"standard-rest-mongo", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "gin", Logger: "zap",
+			UseLogger: true, Dependencies: []string{mongoPackage, websocketPackage, templPackage}}},
+		{"standard-rest-openapi", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "gin", UseJWT: true,
+			OpenAPI: spec}},
+		{"standard-rest-services", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "chi", UseConfig: true,
+			UseDocker: true, UseGitHub: true, Services: []string{"api", "worker"}}},
+		{"standard-cli", ProjectConfig{Structure: "standard", ProjectType: "cli", Logger: "logrus", UseLogger: true, UseDocker: true}},
+		{"standard-grpc", ProjectConfig{Structure: "standard", ProjectType: "grpc", UseConfig: true, UseDocker: true, UseBuf: true}},
+		{"standard-graphql", ProjectConfig{Structure: "standard", ProjectType: "graphql", Router: "echo", UseConfig: true, UseDatabase: true,
+			ConfigStyle: "viper"}},
+		{"standard-library", ProjectConfig{Structure: "standard", ProjectType: "library", UseGitHub: true, License: "apache-2.0",
+			UseDependencyBot: "renovate", DefaultBranch: "master", UseSBOM: true}},
+		{"flat-rest", ProjectConfig{Structure: "flat", ProjectType: "rest-api", Router: "gin", UseDocker: true, CIProvider: "gitlab", UseSBOM: true}},
+		{"flat-cli", ProjectConfig{Structure: "flat", ProjectType: "cli", UseGitHub: true, DefaultBranch: "master"}},
+		{"feature-rest", ProjectConfig{Structure: "feature", ProjectType: "rest-api", Router: "echo", Logger: "zap", Features: []string{"order", "product"},
+			UseLogger: true, UseConfig: true, UseDatabase: true, CIProvider: "circleci", Dependencies: []string{testifyPackage}}},
+		{"feature-cli", ProjectConfig{Structure: "feature", ProjectType: "cli", Features: []string{"user", "order"}, UseDatabase: true}},
+		{"hexagonal-rest", ProjectConfig{Structure: "hexagonal", ProjectType: "rest-api", Router: "fiber", Logger: "slog",
+			UseLogger: true, UseConfig: true, UseDatabase: true, UseDocker: true, PlatformTarget: "railway", CIProvider: "github",
+			Dependencies: []string{kafkaPackage, rabbitMQPackage, natsPackage, testifyPackage, gomockPackage}}},
+		{"hexagonal-cli", ProjectConfig{Structure: "hexagonal", ProjectType: "cli", UseConfig: true}},
+		{"clean-rest", ProjectConfig{Structure: "clean", ProjectType: "rest-api", Router: "chi", UseConfig: true, UseDatabase: true,
+			PlatformTarget: "heroku", Dependencies: []string{gomockPackage}}},
+		{"clean-cli", ProjectConfig{Structure: "clean", ProjectType: "cli", UseDevTooling: true, CIProvider: "circleci", UseSBOM: true}},
+	}
+}
+
+// TestTemplates generates every check case, parses each generated .go file
+// and compares the generated file set with the case's golden list. With
+// -update the golden lists are rewritten instead of compared.
+func TestTemplates(t *testing.T) {
+	g := newTestGenerator()
+	for _, c := range checkCases(t) {
+		t.Run(c.name, func(t *testing.T) {
+			config := c.config
+			config.ProjectName = "sample"
+			config.Module = "example.com/sample"
+			config.GoVersion = "1.22.0"
+			config.Offline = true
+			config.ArchiveRoot = "."
+
+			dir := t.TempDir()
+			if err := g.GenerateToDir(config, dir); err != nil {
+				t.Fatalf("generate: %v", err)
+			}
+
+			files, err := parseGenerated(dir)
+			if err != nil {
+				t.Error(err)
+			}
+
+			goldenPath := filepath.Join("testdata", "golden", c.name+".golden")
+			if *update {
+				list := []byte(strings.Join(files, "\n") + "\n")
+				if err := os.WriteFile(goldenPath, list, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("%v (record it with -update)", err)
+			}
+			if err := diffFileLists(strings.Fields(string(want)), files); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// parseGenerated walks a generated project and parses every Go file, returning
+// the sorted slash-separated paths of all files. Parse errors of every file
+// are reported, not just the first.
+func parseGenerated(dir string) ([]string, error) {
+	var files []string
+	var errs []error
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+
+		if strings.HasSuffix(path, ".go") {
+			if _, err := parser.ParseFile(fset, path, nil, parser.AllErrors); err != nil {
+				errs = append(errs, fmt.Errorf("%s does not parse: %w", filepath.ToSlash(rel), err))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, errors.Join(errs...)
+}
+
+// diffFileLists reports the files missing from got and the unexpected files in it
+func diffFileLists(want, got []string) error {
+	inGot := make(map[string]bool, len(got))
+	for _, f := range got {
+		inGot[f] = true
+	}
+	inWant := make(map[string]bool, len(want))
+	for _, f := range want {
+		inWant[f] = true
+	}
+
+	var diff bytes.Buffer
+	for _, f := range want {
+		if !inGot[f] {
+			fmt.Fprintf(&diff, "\n  missing:    %s", f)
+		}
+	}
+	for _, f := range got {
+		if !inWant[f] {
+			fmt.Fprintf(&diff, "\n  unexpected: %s", f)
+		}
+	}
+	if diff.Len() > 0 {
+		return fmt.Errorf("file list differs from golden list:%s", diff.String())
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateChecksums(t *testing.T) {
+	if err := ValidateChecksums(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateChecksumsCatalogEntryWithoutChecksum(t *testing.T) {
+	catalog := dependencyCatalog
+	t.Cleanup(func() { dependencyCatalog = catalog })
+	dependencyCatalog = append(catalog[:len(catalog):len(catalog)],
+		Dependency{Name: "Example", Category: "UTILITIES", Package: "example.com/nosum", Version: "v1.0.0"})
+
+	err := ValidateChecksums()
+	if err == nil || !strings.Contains(err.Error(), "example.com/nosum@v1.0.0") {
+		t.Errorf("ValidateChecksums() = %v, want an error naming example.com/nosum@v1.0.0", err)
+	}
+}
+
+// checksumCases enable the features that add dependencies outside the catalog
+var checksumCases = []struct {
+	name   string
+	config ProjectConfig
+}{
+	{"database", ProjectConfig{ProjectType: "rest-api", Router: "chi", UseDatabase: true}},
+	{"mysql", ProjectConfig{ProjectType: "rest-api", Router: "chi", UseDatabase: true, Database: "mysql"}},
+	{"sqlite", ProjectConfig{ProjectType: "rest-api", Router: "chi", UseDatabase: true, Database: "sqlite"}},
+	{"graphql", ProjectConfig{Structure: "standard", ProjectType: "graphql", Router: "chi"}},
+	{"swagger", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "chi", UseSwagger: true}},
+	{"fiber-websocket", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "fiber", Dependencies: []string{websocketPackage}}},
+	{"viper", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "chi", UseConfig: true, ConfigStyle: "viper"}},
+	{"dotenv", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "chi", UseDotenv: true}},
+	{"integration-tests", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "chi", UseConfig: true, UseDatabase: true,
+		Dependencies: []string{testifyPackage}}},
+}
+
+func TestDependenciesHaveChecksums(t *testing.T) {
+	g := newTestGenerator()
+	for _, c := range checksumCases {
+		config := c.config
+		config.Offline = true
+		for pkg, version := range g.getDependencies(context.Background(), config) {
+			if _, ok := lookupChecksum(pkg, version); !ok {
+				t.Errorf("%s: no checksum for %s@%s", c.name, pkg, version)
+			}
+		}
+	}
+}
+
+func TestDependenciesHaveModuleGraphs(t *testing.T) {
+	g := newTestGenerator()
+	for _, c := range checksumCases {
+		config := c.config
+		config.Offline = true
+		for pkg, version := range g.getDependencies(context.Background(), config) {
+			if _, ok := moduleGraphs[pkg+"@"+version]; !ok {
+				t.Errorf("%s: no module graph for %s@%s", c.name, pkg, version)
+			}
+		}
+	}
+	for _, d := range dependencyCatalog {
+		if _, ok := moduleGraphs[d.Package+"@"+d.Version]; !d.Stdlib && !ok {
+			t.Errorf("catalog entry %s has no module graph for %s@%s", d.Name, d.Package, d.Version)
+		}
+	}
+}
+
+func TestResolveModulesFollowsRequirements(t *testing.T) {
+	indirect, requirements := indirectDependencies, moduleRequirements
+	t.Cleanup(func() { indirectDependencies, moduleRequirements = indirect, requirements })
+	indirectDependencies = map[string]map[string]string{
+		"example.com/a@v1.0.0": {"example.com/c": "v1.0.0"},
+		"example.com/b@v1.0.0": {"example.com/d": "v1.0.0"},
+	}
+	// d asks for a newer c, whose go.mod in turn asks for a newer a; e is
+	// not listed in go.mod, so its requirement is ignored
+	moduleRequirements = map[string]map[string]string{
+		"example.com/d@v1.0.0": {"example.com/c": "v1.2.0", "example.com/e": "v1.0.0"},
+		"example.com/c@v1.2.0": {"example.com/a": "v1.1.0"},
+	}
+
+	direct, indirectMods := resolveModules(map[string]string{"example.com/a": "v1.0.0", "example.com/b": "v1.0.0"})
+	wantDirect := map[string]string{"example.com/a": "v1.1.0", "example.com/b": "v1.0.0"}
+	wantIndirect := map[string]string{"example.com/c": "v1.2.0", "example.com/d": "v1.0.0"}
+	if !reflect.DeepEqual(direct, wantDirect) || !reflect.DeepEqual(indirectMods, wantIndirect) {
+		t.Errorf("resolveModules() = %v, %v; want %v, %v", direct, indirectMods, wantDirect, wantIndirect)
+	}
+}
+
+func TestGoSumCoversGoMod(t *testing.T) {
+	g := newTestGenerator()
+	// Gin and pgx bring the indirect requirements of indirectDependencies
+	// and the module graphs of moduleGraphs
+	config := testConfig("standard", "rest-api")
+	config.Router, config.UseDatabase = "gin", true
+
+	files, err := g.RenderFiles(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents := make(map[string]string)
+	for _, f := range files {
+		contents[f.Path] = string(f.Content)
+	}
+	sums := make(map[string]bool)
+	for _, line := range strings.Split(contents["go.sum"], "\n") {
+		if fields := strings.Fields(line); len(fields) == 3 {
+			sums[fields[0]+" "+fields[1]] = true
+		}
+	}
+
+	var requires, indirect int
+	for _, line := range strings.Split(contents["go.mod"], "\n") {
+		fields := strings.Fields(line)
+		if !strings.HasPrefix(line, "\t") || len(fields) < 2 {
+			continue
+		}
+		requires++
+		if strings.HasSuffix(line, "// indirect") {
+			indirect++
+		}
+		pkg, version := fields[0], fields[1]
+		if !sums[pkg+" "+version] || !sums[pkg+" "+version+"/go.mod"] {
+			t.Errorf("go.sum is missing the lines of %s %s", pkg, version)
+		}
+		for _, graphLine := range moduleGraphs[pkg+"@"+version] {
+			if !sums[graphLine] {
+				t.Errorf("go.sum is missing %q from the module graph of %s %s", graphLine, pkg, version)
+			}
+		}
+	}
+	if requires == 0 || indirect == 0 {
+		t.Fatalf("go.mod has %d requirements, %d indirect; want both:\n%s", requires, indirect, contents["go.mod"])
+	}
+}
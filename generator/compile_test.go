@@ -0,0 +1,129 @@
+//go:build integration
+
+package generator
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// compileCases is the matrix built by TestCompile: the sample REST API of the
+// standard layout for every router and logger, one case per optional feature,
+// and the main project types of every other layout, whose REST APIs are built
+// with and without a logger. gRPC projects are left out since building them
+// needs protoc.
+func compileCases(t *testing.T) []checkCase {
+	spec := parseCheckOpenAPI(t)
+	opts := GetOptions()
+	var cases []checkCase
+	for _, router := range opts.Routers {
+		for _, logger := range opts.Loggers {
+			cases = append(cases, checkCase{
+				name: fmt.Sprintf("standard-rest-%s-%s", router, logger),
+				config: ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: router, Logger: logger,
+					UseLogger: true, UseConfig: true},
+			})
+		}
+	}
+
+	features := []checkCase{
+		{"postgres", ProjectConfig{UseDatabase: true}},
+		{"mysql", ProjectConfig{UseDatabase: true, Database: "mysql"}},
+		{"sqlite", ProjectConfig{UseDatabase: true, Database: "sqlite"}},
+		{"redis", ProjectConfig{UseRedis: true}},
+		{"jwt", ProjectConfig{UseJWT: true}},
+		{"swagger", ProjectConfig{UseSwagger: true}},
+		{"observability", ProjectConfig{Dependencies: []string{prometheusPackage, openTelemetryPackage}}},
+		{"mongo", ProjectConfig{Dependencies: []string{mongoPackage}}},
+		{"websocket", ProjectConfig{Dependencies: []string{websocketPackage}}},
+		{"templ", ProjectConfig{Dependencies: []string{templPackage}}},
+		{"viper", ProjectConfig{UseDatabase: true, UseLogger: true, ConfigStyle: "viper"}},
+		{"messaging", ProjectConfig{Dependencies: []string{kafkaPackage, rabbitMQPackage, natsPackage}}},
+		{"dotenv", ProjectConfig{UseDotenv: true}},
+	}
+	for _, router := range []string{"chi", "stdlib"} {
+		for _, f := range features {
+			config := f.config
+			config.Structure, config.ProjectType, config.Router = "standard", "rest-api", router
+			config.UseConfig = true
+			cases = append(cases, checkCase{fmt.Sprintf("standard-rest-%s-%s", router, f.name), config})
+		}
+	}
+
+	cases = append(cases,
+		checkCase{"standard-cli", ProjectConfig{Structure: "standard", ProjectType: "cli", UseConfig: true}},
+		checkCase{"standard-graphql", ProjectConfig{Structure: "standard", ProjectType: "graphql", Router: "chi", UseConfig: true}},
+		checkCase{"standard-library", ProjectConfig{Structure: "standard", ProjectType: "library"}},
+		checkCase{"standard-rest-services", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "chi", UseConfig: true,
+			Services: []string{"api", "worker"}}},
+		checkCase{"standard-rest-openapi", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "chi", UseConfig: true,
+			OpenAPI: spec}},
+		checkCase{"standard-rest-stdlib-openapi", ProjectConfig{Structure: "standard", ProjectType: "rest-api", Router: "stdlib", UseConfig: true,
+			OpenAPI: spec}},
+	)
+	for _, structure := range opts.Structures[1:] {
+		cases = append(cases, checkCase{structure + "-rest", ProjectConfig{Structure: structure, ProjectType: "rest-api", Router: "chi", UseConfig: true}})
+		cases = append(cases, checkCase{structure + "-rest-logger", ProjectConfig{Structure: structure, ProjectType: "rest-api", Router: "chi", Logger: "zap",
+			UseLogger: true, UseConfig: true}})
+	}
+	cases = append(cases,
+		checkCase{"flat-cli", ProjectConfig{Structure: "flat", ProjectType: "cli"}},
+		checkCase{"feature-cli", ProjectConfig{Structure: "feature", ProjectType: "cli", UseConfig: true}},
+		checkCase{"hexagonal-cli", ProjectConfig{Structure: "hexagonal", ProjectType: "cli", UseConfig: true}},
+		checkCase{"clean-cli", ProjectConfig{Structure: "clean", ProjectType: "cli"}},
+	)
+	return cases
+}
+
+// TestCompile generates each compile case and builds it with `go mod tidy`
+// and `go build ./...`, using the go command on PATH. GraphQL projects get
+// their gqlgen code generated first, and projects with the view example their
+// templ code. `go vet ./...` runs last, so a template that compiles but trips
+// vet, e.g. with a printf format that doesn't match its arguments, fails too.
+// It needs network access to download the pinned dependencies, so it only
+// runs with the integration build tag; pick cases with -run, such as
+// -run 'TestCompile/standard-rest-(gin|echo)'.
+func TestCompile(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("no go command on PATH")
+	}
+	g := newTestGenerator()
+	for _, c := range compileCases(t) {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			config := c.config
+			config.ProjectName = "sample"
+			config.Module = "example.com/sample"
+			config.GoVersion = "1.22.0"
+			config.Offline = true
+			config.ArchiveRoot = "."
+
+			dir := t.TempDir()
+			if err := g.GenerateToDir(config, dir); err != nil {
+				t.Fatalf("generate: %v", err)
+			}
+
+			steps := [][]string{{"go", "mod", "tidy"}}
+			if config.ProjectType == "graphql" {
+				steps = append(steps, []string{"go", "run", "github.com/99designs/gqlgen", "generate"})
+			}
+			if config.UseTempl() {
+				steps = append(steps, []string{"go", "generate", "./view"})
+			}
+			steps = append(steps, []string{"go", "build", "./..."}, []string{"go", "vet", "./..."})
+
+			for _, step := range steps {
+				cmd := exec.CommandContext(t.Context(), step[0], step[1:]...)
+				cmd.Dir = dir
+				// Keep the generated go.mod authoritative over any workspace of the caller
+				cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("%s: %v\n%s", strings.Join(step, " "), err, out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"go/format"
+	"sync"
+)
+
+// maxFormatCacheEntries bounds the number of formatted sources kept in memory
+const maxFormatCacheEntries = 2048
+
+// formatCache remembers the gofmt output of rendered Go sources. Formatting
+// is by far the most expensive step of rendering a project, and most files
+// come out byte-for-byte the same across requests (and between a preview
+// and the following generate), so they are keyed by the hash of their
+// unformatted content. When the cache is full it is simply emptied.
+type formatCache struct {
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte][]byte
+}
+
+func newFormatCache() *formatCache {
+	return &formatCache{
+		cache: make(map[[sha256.Size]byte][]byte),
+	}
+}
+
+// source returns src formatted like format.Source. The result is always a
+// fresh slice that the caller may keep or modify.
+func (c *formatCache) source(src []byte) ([]byte, error) {
+	key := sha256.Sum256(src)
+
+	c.mu.Lock()
+	formatted, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok {
+		return bytes.Clone(formatted), nil
+	}
+
+	formatted, err := format.Source(src)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if len(c.cache) >= maxFormatCacheEntries {
+		clear(c.cache)
+	}
+	c.cache[key] = formatted
+	c.mu.Unlock()
+
+	return bytes.Clone(formatted), nil
+}
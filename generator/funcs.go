@@ -0,0 +1,222 @@
+package generator
+
+import (
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// commonInitialisms are kept fully upper case in Go identifiers, following
+// the usual Go naming conventions (UserID, not UserId)
+var commonInitialisms = map[string]bool{
+	"API": true, "ASCII": true, "CPU": true, "CSS": true, "DNS": true,
+	"EOF": true, "GRPC": true, "GUID": true, "HTML": true, "HTTP": true,
+	"HTTPS": true, "ID": true, "IP": true, "JSON": true, "JWT": true,
+	"SQL": true, "SSH": true, "TCP": true, "TLS": true, "TTL": true,
+	"UDP": true, "UI": true, "URI": true, "URL": true, "UUID": true,
+	"XML": true,
+}
+
+var (
+	// majorVersionElement matches a "/v2" style major version path element
+	majorVersionElement = regexp.MustCompile(`^v[0-9]+$`)
+
+	// gopkgVersionSuffix matches gopkg.in's ".v2" style major version suffix
+	gopkgVersionSuffix = regexp.MustCompile(`\.v[0-9]+$`)
+)
+
+// splitWords breaks s into words at separators and case changes. A run of
+// upper case letters is one word (an acronym), except that its last letter
+// starts the next word when followed by lower case: "HTTPServer" is
+// ["HTTP", "Server"]. Digits belong to the word they follow.
+func splitWords(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+
+		prev := runes[i-1]
+		boundary := false
+		switch {
+		case unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+			boundary = true
+		case unicode.IsUpper(r) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+			boundary = true
+		}
+		if boundary {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// capitalize returns word with its first letter upper case and the rest
+// lower case, or fully upper case when it is a common initialism
+func capitalize(word string) string {
+	upper := strings.ToUpper(word)
+	if commonInitialisms[upper] {
+		return upper
+	}
+	runes := []rune(strings.ToLower(word))
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// toSnakeCase converts s to lower_snake_case: "MyHTTPServer" becomes
+// "my_http_server"
+func toSnakeCase(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, "_")
+}
+
+// toPascalCase converts s to an exported Go identifier style: "user-id"
+// becomes "UserID"
+func toPascalCase(s string) string {
+	var b strings.Builder
+	for _, w := range splitWords(s) {
+		b.WriteString(capitalize(w))
+	}
+	return b.String()
+}
+
+// toLowerCamel converts s to an unexported Go identifier style: "user-id"
+// becomes "userID" and "HTTPServer" becomes "httpServer"
+func toLowerCamel(s string) string {
+	words := splitWords(s)
+	if len(words) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(strings.ToLower(words[0]))
+	for _, w := range words[1:] {
+		b.WriteString(capitalize(w))
+	}
+	return b.String()
+}
+
+// protoCamel converts a lower_snake_case protobuf name to the Go name
+// protoc-gen-go gives it: each word is capitalized and underscores before a
+// lower case letter are dropped, without the initialisms toPascalCase keeps
+// ("api_key" becomes "ApiKey", not "APIKey")
+func protoCamel(s string) string {
+	var b strings.Builder
+	upper := true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i > 0 && i+1 < len(s) && 'a' <= s[i+1] && s[i+1] <= 'z':
+			upper = true
+			continue
+		case c == '_' && i == 0:
+			// A leading underscore becomes X, and the word after it is capitalized
+			b.WriteByte('X')
+			upper = true
+			continue
+		case upper && 'a' <= c && c <= 'z':
+			c -= 'a' - 'A'
+		}
+		b.WriteByte(c)
+		upper = '0' <= c && c <= '9'
+	}
+	return b.String()
+}
+
+// plural returns the English plural of a lower case noun using the regular
+// spelling rules: "order" becomes "orders", "category" becomes "categories"
+// and "box" becomes "boxes". Irregular nouns get the regular form.
+func plural(word string) string {
+	switch {
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return word[:len(word)-1] + "ies"
+	case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+		strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+		return word + "es"
+	}
+	return word + "s"
+}
+
+// commandName returns the name go install gives the binary built from the
+// main package at the root of module: its last element, skipping a major
+// version suffix. "github.com/foo/my-app/v2" becomes "my-app".
+func commandName(module string) string {
+	module = strings.TrimSuffix(module, "/")
+	base := path.Base(module)
+	if majorVersionElement.MatchString(base) {
+		base = path.Base(path.Dir(module))
+	}
+	return base
+}
+
+// basePackage returns the conventional package name for a module path: its
+// last element without any major version suffix, lower cased and with
+// anything but ASCII letters and digits removed. "github.com/foo/My-App/v2"
+// becomes "myapp".
+func basePackage(module string) string {
+	module = strings.TrimSuffix(module, "/")
+	base := path.Base(module)
+	if majorVersionElement.MatchString(base) {
+		base = path.Base(path.Dir(module))
+	}
+	base = gopkgVersionSuffix.ReplaceAllString(base, "")
+	return identChars(base)
+}
+
+// goKeywords can't be used as package names. "main" is included because a
+// package named main builds a command rather than an importable package.
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true,
+	"default": true, "defer": true, "else": true, "fallthrough": true, "for": true,
+	"func": true, "go": true, "goto": true, "if": true, "import": true,
+	"interface": true, "map": true, "package": true, "range": true, "return": true,
+	"select": true, "struct": true, "switch": true, "type": true, "var": true,
+	"main": true,
+}
+
+// PackageName derives a valid Go package name from a project name. Hyphens
+// and other characters that can't appear in an identifier are dropped and
+// the rest is lower cased, so "My-Service" becomes "myservice". Names that
+// would start with a digit get a "pkg" prefix ("3d-engine" becomes
+// "pkg3dengine"), names that collide with a keyword get a "pkg" suffix
+// ("type" becomes "typepkg"), and names with nothing usable become "app".
+func PackageName(projectName string) string {
+	name := identChars(projectName)
+	switch {
+	case name == "":
+		return "app"
+	case name[0] >= '0' && name[0] <= '9':
+		return "pkg" + name
+	case goKeywords[name]:
+		return name + "pkg"
+	}
+	return name
+}
+
+// identChars lower cases s and keeps only ASCII letters and digits
+func identChars(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
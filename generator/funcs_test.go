@@ -0,0 +1,171 @@
+package generator
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"---", nil},
+		{"user", []string{"user"}},
+		{"user-id", []string{"user", "id"}},
+		{"user_id", []string{"user", "id"}},
+		{"  my  project ", []string{"my", "project"}},
+		{"HTTPServer", []string{"HTTP", "Server"}},
+		{"myHTTPServer", []string{"my", "HTTP", "Server"}},
+		{"userID", []string{"user", "ID"}},
+		{"IDs", []string{"I", "Ds"}},
+		{"v2API", []string{"v2", "API"}},
+		{"oauth2Token", []string{"oauth2", "Token"}},
+		{"3dEngine", []string{"3d", "Engine"}},
+		{"café-crème", []string{"café", "crème"}},
+	}
+	for _, tt := range tests {
+		if got := splitWords(tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("splitWords(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", ""},
+		{"my-project", "my_project"},
+		{"MyHTTPServer", "my_http_server"},
+		{"my.project.v2", "my_project_v2"},
+		{"My Project", "my_project"},
+		{"__x__", "x"},
+	}
+	for _, tt := range tests {
+		if got := toSnakeCase(tt.in); got != tt.want {
+			t.Errorf("toSnakeCase(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestToPascalCase(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", ""},
+		{"user-id", "UserID"},
+		{"http_server", "HTTPServer"},
+		{"api-url", "APIURL"},
+		{"order_item", "OrderItem"},
+		{"ORDER_ITEM", "OrderItem"},
+		{"ids", "Ids"},
+		{"é", "É"},
+	}
+	for _, tt := range tests {
+		if got := toPascalCase(tt.in); got != tt.want {
+			t.Errorf("toPascalCase(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestToLowerCamel(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", ""},
+		{"-", ""},
+		{"user-id", "userID"},
+		{"HTTPServer", "httpServer"},
+		{"ID", "id"},
+		{"order_item_url", "orderItemURL"},
+	}
+	for _, tt := range tests {
+		if got := toLowerCamel(tt.in); got != tt.want {
+			t.Errorf("toLowerCamel(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestProtoCamel(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", ""},
+		{"api_key", "ApiKey"},
+		{"order_id", "OrderId"},
+		{"field2_name", "Field2Name"},
+		{"x_1", "X_1"},
+		{"_private", "XPrivate"},
+		{"trailing_", "Trailing_"},
+		{"already_Upper", "Already_Upper"},
+	}
+	for _, tt := range tests {
+		if got := protoCamel(tt.in); got != tt.want {
+			t.Errorf("protoCamel(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPlural(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"order", "orders"},
+		{"category", "categories"},
+		{"day", "days"},
+		{"y", "ys"},
+		{"box", "boxes"},
+		{"status", "statuses"},
+		{"quiz", "quizes"},
+		{"match", "matches"},
+		{"wish", "wishes"},
+		{"person", "persons"},
+	}
+	for _, tt := range tests {
+		if got := plural(tt.in); got != tt.want {
+			t.Errorf("plural(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCommandName(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"myapp", "myapp"},
+		{"github.com/foo/my-app", "my-app"},
+		{"github.com/foo/my-app/v2", "my-app"},
+		{"github.com/foo/my-app/", "my-app"},
+		{"github.com/foo/v2app", "v2app"},
+		{"gopkg.in/yaml.v3", "yaml.v3"},
+	}
+	for _, tt := range tests {
+		if got := commandName(tt.in); got != tt.want {
+			t.Errorf("commandName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestBasePackage(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"myapp", "myapp"},
+		{"github.com/foo/My-App/v2", "myapp"},
+		{"github.com/foo/go.app", "goapp"},
+		{"gopkg.in/yaml.v3", "yaml"},
+		{"example.com/v10", "examplecom"},
+		{"github.com/foo/app/", "app"},
+	}
+	for _, tt := range tests {
+		if got := basePackage(tt.in); got != tt.want {
+			t.Errorf("basePackage(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"My-Service", "myservice"},
+		{"my_service.v2", "myservicev2"},
+		{"3d-engine", "pkg3dengine"},
+		{"type", "typepkg"},
+		{"main", "mainpkg"},
+		{"Go", "gopkg"},
+		{"---", "app"},
+		{"ünïcode", "ncode"},
+	}
+	for _, tt := range tests {
+		if got := PackageName(tt.in); got != tt.want {
+			t.Errorf("PackageName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
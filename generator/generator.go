@@ -1,34 +1,86 @@
 package generator
 
 import (
+	"archive/tar"
 	"archive/zip"
 	"bytes"
+	"compress/gzip"
+	"context"
 	"embed"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/url"
+	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
 	"text/template"
+	"time"
 )
 
 type Generator struct {
 	templates embed.FS
+	versions  *versionResolver
+
+	// overlays override embedded templates; see New for the precedence rules
+	overlays []fs.FS
+
+	// parsed caches compiled templates by template path; safe for concurrent use
+	parsed sync.Map
+
+	// formatted caches gofmt output of rendered Go sources
+	formatted *formatCache
 }
 
 type ProjectConfig struct {
 	// Core
 	ProjectName string
+	PackageName string // Go package name derived from ProjectName; see PackageName
 	Module      string
 	Description string
 	GoVersion   string
+	Author      string // Optional; used in the README and LICENSE
+	AuthorEmail string // Optional; also makes the author the CODEOWNERS owner
 
 	// Structure
-	Structure   string // "standard", "flat", "feature", "hexagonal"
-	ProjectType string // "rest-api", "cli", "grpc", "library"
+	Structure   string // "standard", "flat", "feature", "hexagonal", "clean"
+	ProjectType string // "rest-api", "cli", "grpc", "graphql", "library"
 
 	// Dependencies
 	Router string // "chi", "gin", "echo", "fiber", "stdlib"
 	Logger string // "zerolog", "zap", "slog", "logrus", "stdlib"
 
+	// Port is the HTTP port the service listens on; zero means DefaultPort
+	Port int
+
+	// FeatureName names the sample feature of the feature layout: its package
+	// under internal/, its type and its routes. Empty means DefaultFeatureName.
+	FeatureName string
+
+	// Features lists the features of the feature layout, each generated like
+	// FeatureName and wired into main in this order. Empty means just
+	// FeatureName; otherwise FeatureName is set to the first entry.
+	Features []string
+
+	// Services lists the services of a monorepo: each gets its own
+	// cmd/<service>/main.go on top of the shared internal/ packages and the
+	// one go.mod, and the Makefile and Dockerfile build every one of them.
+	// Only REST APIs in the standard layout have services; empty means the
+	// single service ProjectName. See UseServices.
+	Services []string
+
+	// ServiceName is the service a file is rendered for. The generator sets
+	// it: per-service files get each of ServiceNames in turn, every other
+	// file gets the first, so files that run one binary, such as .air.toml,
+	// run that one.
+	ServiceName string
+
 	// Optional Features
 	UseDocker   bool
 	UseGitHub   bool
@@ -38,152 +90,1179 @@ type ProjectConfig struct {
 	UseRedis    bool
 	UseJWT      bool
 	UseAir      bool
+	UseLinter   bool
+
+	// UseKubernetes generates manifests under deploy/k8s (requires UseDocker)
+	UseKubernetes bool
+
+	// ConfigStyle selects how the config package loads settings: "env" reads
+	// environment variables only, "viper" reads config.yaml with Viper and
+	// lets the same environment variables override it. Empty means "env".
+	ConfigStyle string // "env", "viper"
+
+	// UseDotenv loads a .env file into the environment at startup with
+	// godotenv; variables that are already set take precedence
+	UseDotenv bool
+
+	// UseDevTooling generates an .editorconfig and a .pre-commit-config.yaml
+	// running gofmt, goimports and golangci-lint
+	UseDevTooling bool
+
+	// License selects the LICENSE file; empty or "none" generates no license
+	License string // "mit", "apache-2.0", "gpl-3.0", "bsd-3-clause", "none"
+
+	// CIProvider selects the CI pipeline; when empty, UseGitHub selects "github"
+	CIProvider string // "github", "gitlab", "circleci", "none"
+
+	// PlatformTarget generates the deploy descriptor of a PaaS for services
+	// that serve HTTP: a Procfile for Heroku, railway.toml for Railway.
+	// Empty means "none".
+	PlatformTarget string // "heroku", "railway", "none"
+
+	// UseDependencyBot generates the configuration of a bot that keeps the
+	// dependencies up to date: .github/dependabot.yml for Dependabot, which
+	// needs the GitHub CI provider, or renovate.json for Renovate. Empty
+	// means "none".
+	UseDependencyBot string // "dependabot", "renovate", "none"
+
+	// UseSBOM adds a step to the CI pipeline's build job that writes a
+	// CycloneDX SBOM of the built binaries with syft (of the module, for a
+	// library) and keeps it as a build artifact. Without a CI provider there
+	// is no pipeline to add it to.
+	UseSBOM bool
+
+	// DefaultBranch is the branch the CI pipeline runs on for pushes and
+	// pull requests, and that the README's CI badge reports on. Empty means
+	// DefaultBranchName.
+	DefaultBranch string
+
+	// Database selects the driver when UseDatabase is set; empty means postgres
+	Database string // "postgres", "mysql", "sqlite"
+
+	// UseSqlc generates a sqlc configuration, schema and queries (Postgres only)
+	UseSqlc bool
+
+	// UseBuf generates buf.yaml and buf.gen.yaml for the protobuf definitions
+	// of gRPC services, and makes `make proto` run buf instead of protoc
+	UseBuf bool
+
+	// UseSwagger generates docs/openapi.yaml for the sample endpoints and
+	// serves it with Swagger UI at /swagger (standard layout REST APIs only)
+	UseSwagger bool
+
+	// OpenAPI scaffolds internal/openapi from an uploaded document: a
+	// handler stub for each of its operations and a type for each of its
+	// schemas, registered on the router next to the sample routes. See
+	// ParseOpenAPI and UseOpenAPIHandlers.
+	OpenAPI *APISpec
 
 	// Dependencies list
 	Dependencies []string
+
+	// DependencyVersions overrides the catalog version of entries in
+	// Dependencies, keyed by the same name or package path
+	DependencyVersions map[string]string
+
+	// ResolveLatest queries the module proxy for the newest version of each
+	// dependency instead of using the pinned versions
+	ResolveLatest bool
+
+	// Offline guarantees generation never touches the network: versions come
+	// from the catalog (or DependencyVersions), checksums from the embedded
+	// table, and ResolveLatest is ignored
+	Offline bool
+
+	// ArchiveRoot is the top-level directory of the archive, and of the tree
+	// GenerateToDir writes under destDir. Empty means ProjectName; "." puts
+	// the files at the top level, so the archive extracts into the current
+	// directory. Other values must pass ValidateArchiveRoot.
+	ArchiveRoot string
+
+	// IncludeManifest adds ManifestFile, a record of the options used, at the
+	// project root
+	IncludeManifest bool
+}
+
+const (
+	// prometheusPackage is the catalog package that turns on metrics scaffolding
+	prometheusPackage = "github.com/prometheus/client_golang"
+
+	// openTelemetryPackage is the catalog package that turns on tracing scaffolding
+	openTelemetryPackage = "go.opentelemetry.io/otel"
+
+	// mongoPackage is the catalog package that turns on the MongoDB repository
+	mongoPackage = "go.mongodb.org/mongo-driver"
+
+	// websocketPackage is the catalog package that turns on the WebSocket example
+	websocketPackage = "github.com/gorilla/websocket"
+
+	// templPackage is the catalog package that turns on the templ view example
+	templPackage = "github.com/a-h/templ"
+
+	// gomockPackage is the catalog package that adds mockgen directives for
+	// the repository interfaces
+	gomockPackage = "go.uber.org/mock"
+
+	// testifyPackage is the catalog package that turns on the service unit
+	// tests and, with a server database, the testcontainers integration test
+	testifyPackage = "github.com/stretchr/testify"
+
+	// kafkaPackage, rabbitMQPackage and natsPackage are the catalog packages
+	// that turn on the matching client in internal/messaging
+	kafkaPackage    = "github.com/IBM/sarama"
+	rabbitMQPackage = "github.com/rabbitmq/amqp091-go"
+	natsPackage     = "github.com/nats-io/nats.go"
+)
+
+// HasDependency reports whether pkg was selected in Dependencies, by display
+// name or package path
+func (c ProjectConfig) HasDependency(pkg string) bool {
+	for _, dep := range c.Dependencies {
+		if d, ok := lookupDependency(dep); ok && d.Package == pkg {
+			return true
+		}
+	}
+	return false
+}
+
+// UsePrometheus reports whether the Prometheus client was selected, which
+// generates the metrics package and mounts /metrics on REST services
+func (c ProjectConfig) UsePrometheus() bool {
+	return c.HasDependency(prometheusPackage)
+}
+
+// UseOpenTelemetry reports whether OpenTelemetry was selected, which
+// generates the tracing package and instruments the router
+func (c ProjectConfig) UseOpenTelemetry() bool {
+	return c.HasDependency(openTelemetryPackage)
+}
+
+// UseMongo reports whether the user repository is backed by MongoDB: the
+// MongoDB driver was selected for a layout with a repository (feature or
+// hexagonal). MongoDB then replaces any SQL database.
+func (c ProjectConfig) UseMongo() bool {
+	if c.Structure != "feature" && c.Structure != "hexagonal" {
+		return false
+	}
+	return c.HasDependency(mongoPackage)
+}
+
+// UseSQLDatabase reports whether a SQL database was selected, which generates
+// the driver setup and the migrations under migrations/
+func (c ProjectConfig) UseSQLDatabase() bool {
+	if !c.UseDatabase || c.UseMongo() {
+		return false
+	}
+	switch c.Database {
+	case "", "postgres", "mysql", "sqlite":
+		return true
+	}
+	return false
+}
+
+// UseIntegrationTests reports whether the database package gets an
+// integration test that runs against a testcontainers database: Testify was
+// selected together with Postgres or MySQL (SQLite needs no container) in a
+// layout that generates the database package
+func (c ProjectConfig) UseIntegrationTests() bool {
+	if !c.UseSQLDatabase() || c.Database == "sqlite" || !c.HasDependency(testifyPackage) {
+		return false
+	}
+	switch c.Structure {
+	case "", "standard":
+		return c.UseConfig
+	case "feature":
+		return true
+	}
+	return false
+}
+
+// UseGoMock reports whether the repository interfaces get mockgen
+// directives and the Makefile a mocks target: GoMock was selected for a
+// layout that declares such an interface (feature, hexagonal or clean)
+func (c ProjectConfig) UseGoMock() bool {
+	if !c.HasDependency(gomockPackage) {
+		return false
+	}
+	switch c.Structure {
+	case "feature", "hexagonal", "clean":
+		return true
+	}
+	return false
+}
+
+// UseSqlcQueries reports whether sqlc files are generated: sqlc was requested
+// and the database is Postgres
+func (c ProjectConfig) UseSqlcQueries() bool {
+	return c.UseSqlc && c.UseSQLDatabase() && (c.Database == "" || c.Database == "postgres")
+}
+
+// ListenPort returns the HTTP port the generated service listens on. The
+// main package, config defaults, Dockerfile, compose file and Kubernetes
+// manifests all use it, so they agree on one port.
+func (c ProjectConfig) ListenPort() int {
+	if c.Port == 0 {
+		return DefaultPort
+	}
+	return c.Port
+}
+
+// CIBadge returns the Markdown of a status badge for the CI pipeline on
+// DefaultBranch, or "" when no CI provider is selected or the module path
+// doesn't name a repository on the provider's host: github.com for GitHub
+// Actions and CircleCI, gitlab.com for GitLab CI.
+func (c ProjectConfig) CIBadge() string {
+	host, repo, ok := strings.Cut(c.Module, "/")
+	if !ok {
+		return ""
+	}
+	// A major version suffix is part of the module path, not the repository
+	if dir, last := path.Split(repo); dir != "" && isMajorVersion(last) {
+		repo = strings.TrimSuffix(dir, "/")
+	}
+	if strings.Count(repo, "/") == 0 {
+		return ""
+	}
+	branch := url.PathEscape(c.DefaultBranch)
+
+	switch {
+	case ciProvider(c) == "github" && host == "github.com":
+		// GitHub repositories are owner/name; packages below it aren't part of the URL
+		repo = strings.Join(strings.SplitN(repo, "/", 3)[:2], "/")
+		query := url.QueryEscape(c.DefaultBranch)
+		return fmt.Sprintf("[![CI](https://github.com/%s/actions/workflows/ci.yml/badge.svg?branch=%s)](https://github.com/%s/actions/workflows/ci.yml?query=branch%%3A%s)",
+			repo, query, repo, query)
+	case ciProvider(c) == "circleci" && host == "github.com":
+		repo = strings.Join(strings.SplitN(repo, "/", 3)[:2], "/")
+		return fmt.Sprintf("[![CircleCI](https://dl.circleci.com/status-badge/img/gh/%s/tree/%s.svg?style=svg)](https://dl.circleci.com/status-badge/redirect/gh/%s/tree/%s)",
+			repo, branch, repo, branch)
+	case ciProvider(c) == "gitlab" && host == "gitlab.com":
+		// GitLab projects may sit in nested groups, so the whole path is kept
+		return fmt.Sprintf("[![pipeline status](https://gitlab.com/%s/badges/%s/pipeline.svg)](https://gitlab.com/%s/-/commits/%s)",
+			repo, branch, repo, branch)
+	}
+	return ""
+}
+
+// isMajorVersion reports whether elem is a module path major version suffix
+// such as v2
+func isMajorVersion(elem string) bool {
+	if len(elem) < 2 || elem[0] != 'v' {
+		return false
+	}
+	for _, r := range elem[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return elem != "v0" && elem != "v1"
+}
+
+// UseSwaggerDocs reports whether the OpenAPI spec and Swagger UI are
+// generated: Swagger was requested for a REST API in the standard layout,
+// the only one whose entrypoints mount them
+func (c ProjectConfig) UseSwaggerDocs() bool {
+	return c.UseSwagger && c.ProjectType == "rest-api" && (c.Structure == "standard" || c.Structure == "")
+}
+
+// UseOpenAPIHandlers reports whether handlers are generated from an OpenAPI
+// document: one was given for a REST API in the standard layout
+func (c ProjectConfig) UseOpenAPIHandlers() bool {
+	return c.OpenAPI != nil && c.ProjectType == "rest-api" && (c.Structure == "standard" || c.Structure == "")
+}
+
+// UseBufTooling reports whether protobuf code is generated with buf: buf was
+// requested for a gRPC service in a layout that ships .proto files (standard
+// or feature)
+func (c ProjectConfig) UseBufTooling() bool {
+	return c.UseBuf && c.ProjectType == "grpc" && (c.Structure == "standard" || c.Structure == "" || c.Structure == "feature")
+}
+
+// UseWebSocket reports whether the WebSocket echo example is generated:
+// Gorilla WebSocket was selected for a REST API in the standard layout, whose
+// entrypoints mount it at /ws
+func (c ProjectConfig) UseWebSocket() bool {
+	return c.HasDependency(websocketPackage) && c.ProjectType == "rest-api" && (c.Structure == "standard" || c.Structure == "")
+}
+
+// UseTempl reports whether the templ view example is generated: Templ was
+// selected for a REST API in the standard layout, whose entrypoints render
+// the view package at /page
+func (c ProjectConfig) UseTempl() bool {
+	return c.HasDependency(templPackage) && c.ProjectType == "rest-api" && (c.Structure == "standard" || c.Structure == "")
+}
+
+// UseViper reports whether the config package is generated on top of Viper:
+// the "viper" config style was chosen for a layout whose config package
+// loads the server, database and log settings (standard with UseConfig, or
+// feature). It adds config.yaml at the project root.
+func (c ProjectConfig) UseViper() bool {
+	if c.ConfigStyle != "viper" {
+		return false
+	}
+	switch c.Structure {
+	case "", "standard":
+		return c.UseConfig
+	case "feature":
+		return true
+	}
+	return false
+}
+
+// UseDotenvLoader reports whether the entrypoint loads .env with godotenv:
+// UseDotenv was requested for a project that has a main package
+func (c ProjectConfig) UseDotenvLoader() bool {
+	return c.UseDotenv && c.ProjectType != "library"
+}
+
+// UseServices reports whether the project is a monorepo of several
+// services: Services was set for a REST API in the standard layout
+func (c ProjectConfig) UseServices() bool {
+	return len(c.Services) > 0 && c.ProjectType == "rest-api" && (c.Structure == "standard" || c.Structure == "")
+}
+
+// ServiceNames returns the services that get an entrypoint under cmd/:
+// Services for a monorepo, otherwise just ProjectName
+func (c ProjectConfig) ServiceNames() []string {
+	if c.UseServices() {
+		return c.Services
+	}
+	return []string{c.ProjectName}
 }
 
-func New(templates embed.FS) *Generator {
+// ArchiveDir returns the directory the generated files are placed under:
+// ArchiveRoot, ProjectName when it is empty, or "" for "."
+func (c ProjectConfig) ArchiveDir() string {
+	switch c.ArchiveRoot {
+	case "":
+		return c.ProjectName
+	case ".":
+		return ""
+	}
+	return c.ArchiveRoot
+}
+
+// UseKafka reports whether the Sarama Kafka client was selected, which
+// generates a producer and a consumer group in internal/messaging
+func (c ProjectConfig) UseKafka() bool {
+	return c.HasDependency(kafkaPackage)
+}
+
+// UseRabbitMQ reports whether the RabbitMQ client was selected, which
+// generates a queue publisher and consumer in internal/messaging
+func (c ProjectConfig) UseRabbitMQ() bool {
+	return c.HasDependency(rabbitMQPackage)
+}
+
+// UseNATS reports whether the NATS client was selected, which generates a
+// subject publisher and queue subscriber in internal/messaging
+func (c ProjectConfig) UseNATS() bool {
+	return c.HasDependency(natsPackage)
+}
+
+// UseMessaging reports whether any message broker client was selected
+func (c ProjectConfig) UseMessaging() bool {
+	return c.UseKafka() || c.UseRabbitMQ() || c.UseNATS()
+}
+
+// maxPooledBufferSize caps the buffers returned to bufferPool so one unusually
+// large file doesn't pin its memory for the life of the process
+const maxPooledBufferSize = 1 << 20
+
+// bufferPool holds the scratch buffers templates are rendered into
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBufferSize {
+		bufferPool.Put(buf)
+	}
+}
+
+// templateFuncs are the helper functions available to every template
+var templateFuncs = template.FuncMap{
+	"currentYear":  func() int { return time.Now().Year() },
+	"toSnakeCase":  toSnakeCase,
+	"toPascalCase": toPascalCase,
+	"toLowerCamel": toLowerCamel,
+	"basePackage":  basePackage,
+	"commandName":  commandName,
+	"plural":       plural,
+	"protoCamel":   protoCamel,
+
+	// generatorVersion is the go-initializer build that rendered the project
+	"generatorVersion": func() string { return Version },
+}
+
+// New returns a generator rendering the templates under templates/ in the
+// embedded file system.
+//
+// Each overlay is a file system laid out like that directory, without the
+// templates/ prefix: "standard/Dockerfile.tmpl" replaces the embedded
+// "templates/standard/Dockerfile.tmpl". A template found in an overlay takes
+// precedence over the embedded one, and later overlays take precedence over
+// earlier ones. Overlays only replace templates: the file mappings decide
+// which files are generated, so files no mapping refers to are ignored.
+func New(templates embed.FS, overlays ...fs.FS) *Generator {
 	return &Generator{
 		templates: templates,
+		overlays:  overlays,
+		versions:  newVersionResolver(),
+		formatted: newFormatCache(),
 	}
 }
 
-// Generate creates a zip file containing the generated project
+// GeneratedFile is a single rendered file, relative to the project root
+type GeneratedFile struct {
+	Path    string
+	Content []byte
+}
+
+// Generate creates a zip file containing the generated project.
+//
+// Generation only reaches the network when config.ResolveLatest asks for
+// the newest dependency versions. With config.Offline set it never does, so
+// it is safe for air-gapped environments: dependency versions and go.sum
+// entries come solely from the built-in tables.
 func (g *Generator) Generate(config ProjectConfig) ([]byte, error) {
-	// Create a buffer to write our zip to
-	buf := new(bytes.Buffer)
-	zipWriter := zip.NewWriter(buf)
+	return g.GenerateContext(context.Background(), config)
+}
+
+// GenerateContext is Generate with cancellation; see RenderFilesContext
+func (g *Generator) GenerateContext(ctx context.Context, config ProjectConfig) ([]byte, error) {
+	files, err := g.RenderFilesContext(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a buffer to write our zip to, sized so it rarely has to grow
+	buf := bytes.NewBuffer(make([]byte, 0, archiveSizeHint(files)))
+	if err := writeZip(buf, config.ArchiveDir(), files); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GenerateStream writes the zip of the generated project to w as it is
+// built, instead of holding the whole archive in memory. The project is
+// rendered before anything is written, so a rendering error leaves w
+// untouched; only a failed write can leave a partial archive behind.
+func (g *Generator) GenerateStream(config ProjectConfig, w io.Writer) error {
+	return g.GenerateStreamContext(context.Background(), config, w)
+}
+
+// GenerateStreamContext is GenerateStream with cancellation; see
+// RenderFilesContext
+func (g *Generator) GenerateStreamContext(ctx context.Context, config ProjectConfig, w io.Writer) error {
+	files, err := g.RenderFilesContext(ctx, config)
+	if err != nil {
+		return err
+	}
+	return writeZip(w, config.ArchiveDir(), files)
+}
+
+// WriteZip writes already rendered files to w as the zip GenerateStream
+// produces for config. Callers that need the files before the archive, e.g.
+// to report their count, pair it with RenderFilesContext.
+func WriteZip(w io.Writer, config ProjectConfig, files []GeneratedFile) error {
+	return writeZip(w, config.ArchiveDir(), files)
+}
+
+// writeZip writes files to w as a zip archive rooted at root, which is empty
+// for an archive without a top-level directory
+func writeZip(w io.Writer, root string, files []GeneratedFile) error {
+	zipWriter := zip.NewWriter(w)
+
+	for _, file := range files {
+		fullPath, err := archivePath(root, file.Path)
+		if err != nil {
+			return err
+		}
+		f, err := zipWriter.Create(fullPath)
+		if err != nil {
+			return fmt.Errorf("failed to create zip entry %s: %w", fullPath, err)
+		}
+
+		if _, err := f.Write(file.Content); err != nil {
+			return fmt.Errorf("failed to write to zip entry %s: %w", fullPath, err)
+		}
+	}
+
+	// Close the zip writer
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close zip writer: %w", err)
+	}
+	return nil
+}
+
+// GenerateTarGz creates a gzip-compressed tarball containing the generated project
+func (g *Generator) GenerateTarGz(config ProjectConfig) ([]byte, error) {
+	return g.GenerateTarGzContext(context.Background(), config)
+}
+
+// GenerateTarGzContext is GenerateTarGz with cancellation; see
+// RenderFilesContext
+func (g *Generator) GenerateTarGzContext(ctx context.Context, config ProjectConfig) ([]byte, error) {
+	files, err := g.RenderFilesContext(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, archiveSizeHint(files)))
+	if err := writeTarGz(buf, config.ArchiveDir(), files); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GenerateTarGzStream is the tarball counterpart of GenerateStream
+func (g *Generator) GenerateTarGzStream(config ProjectConfig, w io.Writer) error {
+	return g.GenerateTarGzStreamContext(context.Background(), config, w)
+}
+
+// GenerateTarGzStreamContext is the tarball counterpart of
+// GenerateStreamContext
+func (g *Generator) GenerateTarGzStreamContext(ctx context.Context, config ProjectConfig, w io.Writer) error {
+	files, err := g.RenderFilesContext(ctx, config)
+	if err != nil {
+		return err
+	}
+	return writeTarGz(w, config.ArchiveDir(), files)
+}
+
+// WriteTarGz is the tarball counterpart of WriteZip
+func WriteTarGz(w io.Writer, config ProjectConfig, files []GeneratedFile) error {
+	return writeTarGz(w, config.ArchiveDir(), files)
+}
+
+// writeTarGz writes files to w as a gzip-compressed tarball rooted at root,
+// which is empty for a tarball without a top-level directory
+func writeTarGz(w io.Writer, root string, files []GeneratedFile) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	// Only regular files are written; tar does not need directory entries
+	modTime := time.Now()
+	for _, file := range files {
+		fullPath, err := archivePath(root, file.Path)
+		if err != nil {
+			return err
+		}
+		header := &tar.Header{
+			Name:    fullPath,
+			Mode:    0644,
+			Size:    int64(len(file.Content)),
+			ModTime: modTime,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to create tar entry %s: %w", fullPath, err)
+		}
+
+		if _, err := tarWriter.Write(file.Content); err != nil {
+			return fmt.Errorf("failed to write to tar entry %s: %w", fullPath, err)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close tar writer: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close gzip writer: %w", err)
+	}
+	return nil
+}
+
+// archivePath joins root and a generated file path into an archive entry
+// name, refusing names that would extract outside the current directory
+func archivePath(root, file string) (string, error) {
+	fullPath := path.Join(root, file)
+	if !filepath.IsLocal(filepath.FromSlash(fullPath)) {
+		return "", fmt.Errorf("refusing to archive %s outside the archive root", file)
+	}
+	return fullPath, nil
+}
+
+// archiveSizeHint estimates the compressed size of an archive of files. The
+// generated sources compress to well under half their size, so this is
+// usually enough to build the whole archive without growing the buffer.
+func archiveSizeHint(files []GeneratedFile) int {
+	size := 0
+	for _, file := range files {
+		size += len(file.Content)
+	}
+	return size / 2
+}
+
+// GenerateToDir renders the project and writes it to destDir laid out as the
+// archive extracts: under ArchiveDir, or directly in destDir when ArchiveRoot
+// is ".". Missing directories are created and existing files are overwritten.
+// Every output path is checked to stay inside destDir, so a crafted project
+// name or archive root can't write elsewhere on disk.
+func (g *Generator) GenerateToDir(config ProjectConfig, destDir string) error {
+	if err := ValidateProjectName(config.ProjectName); err != nil {
+		return err
+	}
+
+	files, err := g.RenderFiles(config)
+	if err != nil {
+		return err
+	}
+
+	root := config.ArchiveDir()
+	for _, file := range files {
+		rel := filepath.FromSlash(path.Join(root, file.Path))
+		if !filepath.IsLocal(rel) {
+			return fmt.Errorf("refusing to write %s outside %s", file.Path, destDir)
+		}
+		fullPath := filepath.Join(destDir, rel)
+
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", fullPath, err)
+		}
+		if err := os.WriteFile(fullPath, file.Content, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", fullPath, err)
+		}
+	}
+
+	return nil
+}
+
+// RenderFiles renders every file of the project, including go.mod, go.sum and,
+// with IncludeManifest, the manifest. An empty PackageName is derived from
+// ProjectName.
+func (g *Generator) RenderFiles(config ProjectConfig) ([]GeneratedFile, error) {
+	return g.RenderFilesContext(context.Background(), config)
+}
+
+// RenderFilesContext is RenderFiles with cancellation: ctx is checked between
+// files and bounds the module proxy lookups of ResolveLatest. A canceled
+// context stops rendering with ctx.Err().
+func (g *Generator) RenderFilesContext(ctx context.Context, config ProjectConfig) ([]GeneratedFile, error) {
+	var files []GeneratedFile
+	config, err := prepareConfig(config)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get file mappings for the selected structure
 	mappings := GetFileMappings(config.Structure)
 
-	// Generate each file
+	// Templates are rendered into one scratch buffer; each file gets its own copy
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	// Generate each file; missing templates are collected so they are all reported at once
+	var missing []string
 	for _, mapping := range mappings {
-		// Check condition
-		if mapping.Condition != nil && !mapping.Condition(config) {
-			continue
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
 
-		// Read template
-		templatePath := "templates/" + mapping.TemplatePath
-		templateData, err := g.templates.ReadFile(templatePath)
-		if err != nil {
-			// Skip files that don't exist
-			continue
+		for _, c := range mappingConfigs(mapping, config) {
+			// Check condition
+			if mapping.Condition != nil && !mapping.Condition(c) {
+				continue
+			}
+
+			file, err := g.renderOutput(buf, mapping, c)
+			if errors.Is(err, fs.ErrNotExist) {
+				missing = append(missing, mapping.TemplatePath)
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			files = append(files, file)
 		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing templates: %s", strings.Join(missing, ", "))
+	}
 
-		// Process output path (replace template variables)
-		outputPath := g.processPath(mapping.OutputPath, config)
+	deps := g.getDependencies(ctx, config)
+	if err := ctx.Err(); err != nil {
+		return nil, err // lookups that were cut short left pinned versions behind
+	}
+	files = append(files,
+		GeneratedFile{Path: "go.mod", Content: g.generateGoMod(config, deps)},
+		GeneratedFile{Path: "go.sum", Content: generateGoSum(deps)},
+	)
 
-		// Parse and execute template
-		tmpl, err := template.New(mapping.TemplatePath).Parse(string(templateData))
+	if config.IncludeManifest {
+		manifest, err := generateManifest(config, deps)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse template %s: %w", mapping.TemplatePath, err)
+			return nil, fmt.Errorf("failed to generate %s: %w", ManifestFile, err)
 		}
+		files = append(files, GeneratedFile{Path: ManifestFile, Content: manifest})
+	}
 
-		var content bytes.Buffer
-		if err := tmpl.Execute(&content, config); err != nil {
-			return nil, fmt.Errorf("failed to execute template %s: %w", mapping.TemplatePath, err)
-		}
+	return files, nil
+}
 
-		// Add file to zip
-		fullPath := filepath.Join(config.ProjectName, outputPath)
-		f, err := zipWriter.Create(fullPath)
+// ErrFileNotGenerated is returned by RenderFile for a path that is not part
+// of the project generated for the config
+var ErrFileNotGenerated = errors.New("file is not generated for this configuration")
+
+// RenderFile renders the single project file at path, which is relative to
+// the project root, with the same content RenderFiles produces for it
+func (g *Generator) RenderFile(config ProjectConfig, path string) (GeneratedFile, error) {
+	return g.RenderFileContext(context.Background(), config, path)
+}
+
+// RenderFileContext is RenderFile with cancellation: ctx bounds the module
+// proxy lookups of go.mod, go.sum and the manifest, and a canceled context
+// stops rendering with ctx.Err()
+func (g *Generator) RenderFileContext(ctx context.Context, config ProjectConfig, path string) (GeneratedFile, error) {
+	config, err := prepareConfig(config)
+	if err != nil {
+		return GeneratedFile{}, err
+	}
+
+	switch path {
+	case "go.mod", "go.sum", ManifestFile:
+		if path == ManifestFile && !config.IncludeManifest {
+			return GeneratedFile{}, ErrFileNotGenerated
+		}
+		deps := g.getDependencies(ctx, config)
+		if err := ctx.Err(); err != nil {
+			return GeneratedFile{}, err
+		}
+		switch path {
+		case "go.mod":
+			return GeneratedFile{Path: path, Content: g.generateGoMod(config, deps)}, nil
+		case "go.sum":
+			return GeneratedFile{Path: path, Content: generateGoSum(deps)}, nil
+		}
+		manifest, err := generateManifest(config, deps)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create zip entry %s: %w", fullPath, err)
+			return GeneratedFile{}, fmt.Errorf("failed to generate %s: %w", ManifestFile, err)
+		}
+		return GeneratedFile{Path: path, Content: manifest}, nil
+	}
+
+	for _, mapping := range GetFileMappings(config.Structure) {
+		for _, c := range mappingConfigs(mapping, config) {
+			if mapping.Condition != nil && !mapping.Condition(c) {
+				continue
+			}
+			if g.processPath(mapping.OutputPath, c) != path {
+				continue
+			}
+
+			buf := getBuffer()
+			defer putBuffer(buf)
+			return g.renderOutput(buf, mapping, c)
 		}
+	}
+	return GeneratedFile{}, ErrFileNotGenerated
+}
 
-		if _, err := f.Write(content.Bytes()); err != nil {
-			return nil, fmt.Errorf("failed to write to zip entry %s: %w", fullPath, err)
+// prepareConfig derives an empty PackageName from ProjectName, defaults an
+// empty FeatureName, lists it as the only feature when Features is empty,
+// sets ServiceName to the first service and validates the structure, port,
+// features, services and archive root
+func prepareConfig(config ProjectConfig) (ProjectConfig, error) {
+	if err := ValidateStructure(config.Structure); err != nil {
+		return config, err
+	}
+	if err := ValidateProjectType(config.ProjectType, config.Structure); err != nil {
+		return config, err
+	}
+	if config.PackageName == "" {
+		config.PackageName = PackageName(config.ProjectName)
+	}
+	if config.Port != 0 {
+		if err := ValidatePort(config.Port); err != nil {
+			return config, err
 		}
 	}
+	if len(config.Features) > 0 {
+		if err := ValidateFeatures(config.Features); err != nil {
+			return config, err
+		}
+		config.Features = slices.Clone(config.Features)
+		config.FeatureName = config.Features[0]
+	} else {
+		if config.FeatureName == "" {
+			config.FeatureName = DefaultFeatureName
+		} else if err := ValidateFeatureName(config.FeatureName); err != nil {
+			return config, err
+		}
+		config.Features = []string{config.FeatureName}
+	}
+	if len(config.Services) > 0 {
+		if err := ValidateServices(config.Services); err != nil {
+			return config, err
+		}
+		config.Services = slices.Clone(config.Services)
+	}
+	config.ServiceName = config.ServiceNames()[0]
+	if config.DefaultBranch == "" {
+		config.DefaultBranch = DefaultBranchName
+	} else if err := ValidateBranchName(config.DefaultBranch); err != nil {
+		return config, err
+	}
+	if err := ValidateArchiveRoot(config.ArchiveRoot); err != nil {
+		return config, err
+	}
 
-	// Generate go.mod
-	if err := g.generateGoMod(zipWriter, config); err != nil {
-		return nil, fmt.Errorf("failed to generate go.mod: %w", err)
+	config, duplicates := dedupeDependencies(config)
+	if len(duplicates) > 0 {
+		log.Printf("Warning: dropping duplicate dependencies %s", strings.Join(duplicates, ", "))
 	}
+	return config, nil
+}
 
-	// Generate go.sum (empty file)
-	goSumPath := filepath.Join(config.ProjectName, "go.sum")
-	if _, err := zipWriter.Create(goSumPath); err != nil {
-		return nil, fmt.Errorf("failed to create go.sum: %w", err)
+// renderOutput renders the file of a mapping using buf as scratch space. Go
+// sources are gofmt'ed; a failure there means the template rendered invalid Go.
+func (g *Generator) renderOutput(buf *bytes.Buffer, mapping FileMapping, config ProjectConfig) (GeneratedFile, error) {
+	buf.Reset()
+	if err := g.renderFile(buf, mapping, config); err != nil {
+		return GeneratedFile{}, err
 	}
 
-	// Close the zip writer
-	if err := zipWriter.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close zip writer: %w", err)
+	// Process output path (replace template variables)
+	outputPath := g.processPath(mapping.OutputPath, config)
+
+	var content []byte
+	if strings.HasSuffix(outputPath, ".go") {
+		formatted, err := g.formatted.source(buf.Bytes())
+		if err != nil {
+			return GeneratedFile{}, fmt.Errorf("failed to format %s (template %s): %w", outputPath, mapping.TemplatePath, err)
+		}
+		content = formatted
+	} else {
+		content = bytes.Clone(buf.Bytes())
 	}
 
-	return buf.Bytes(), nil
+	return GeneratedFile{Path: outputPath, Content: content}, nil
 }
 
-// GetFileList returns a list of files that would be generated
-func (g *Generator) GetFileList(config ProjectConfig) []string {
-	var files []string
+// renderFile executes the template for a mapping with the config, writing the result to buf
+func (g *Generator) renderFile(buf *bytes.Buffer, mapping FileMapping, config ProjectConfig) error {
+	tmpl, err := g.loadTemplate(mapping.TemplatePath)
+	if err != nil {
+		return err
+	}
 
-	mappings := GetFileMappings(config.Structure)
+	if err := tmpl.Execute(buf, config); err != nil {
+		return fmt.Errorf("failed to execute template %s: %w", mapping.TemplatePath, err)
+	}
 
-	for _, mapping := range mappings {
-		if mapping.Condition != nil && !mapping.Condition(config) {
-			continue
-		}
+	return nil
+}
+
+// loadTemplate returns the compiled template at path, parsing and caching it on first use
+func (g *Generator) loadTemplate(path string) (*template.Template, error) {
+	if tmpl, ok := g.parsed.Load(path); ok {
+		return tmpl.(*template.Template), nil
+	}
+
+	templateData, err := g.readTemplate(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %s: %w", path, err)
+	}
 
-		outputPath := g.processPath(mapping.OutputPath, config)
-		files = append(files, outputPath)
+	tmpl, err := template.New(path).Funcs(templateFuncs).Parse(string(templateData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", path, err)
+	}
+
+	// Another request may have parsed the same template concurrently; keep the first
+	actual, _ := g.parsed.LoadOrStore(path, tmpl)
+	return actual.(*template.Template), nil
+}
+
+// readTemplate returns the source of the template at path, from the last
+// overlay that has it or else from the embedded templates
+func (g *Generator) readTemplate(path string) ([]byte, error) {
+	for i := len(g.overlays) - 1; i >= 0; i-- {
+		data, err := fs.ReadFile(g.overlays[i], path)
+		if err == nil {
+			return data, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("overlay %d: %w", i+1, err)
+		}
 	}
+	return g.templates.ReadFile("templates/" + path)
+}
+
+// ValidateTemplates checks that every file mapping of every structure points
+// at a template that exists, regardless of the mapping's condition. Templates
+// replaced by an overlay are parsed as well, so a broken override is reported
+// up front rather than on the first request that renders it.
+func (g *Generator) ValidateTemplates() error {
+	seen := make(map[string]bool)
+	var missing []string
+	var errs []error
+	for _, structure := range GetOptions().Structures {
+		for _, mapping := range GetFileMappings(structure) {
+			if seen[mapping.TemplatePath] {
+				continue
+			}
+			seen[mapping.TemplatePath] = true
 
-	files = append(files, "go.mod", "go.sum")
+			if _, err := fs.Stat(g.templates, "templates/"+mapping.TemplatePath); err != nil {
+				missing = append(missing, mapping.TemplatePath)
+				continue
+			}
+			if g.overridden(mapping.TemplatePath) {
+				if _, err := g.loadTemplate(mapping.TemplatePath); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		errs = append(errs, fmt.Errorf("missing templates: %s", strings.Join(missing, ", ")))
+	}
+	return errors.Join(errs...)
+}
 
-	return files
+// overridden reports whether an overlay provides the template at path
+func (g *Generator) overridden(path string) bool {
+	for _, overlay := range g.overlays {
+		if _, err := fs.Stat(overlay, path); err == nil {
+			return true
+		}
+	}
+	return false
 }
 
 // processPath replaces template variables in the path
 func (g *Generator) processPath(path string, config ProjectConfig) string {
 	path = strings.ReplaceAll(path, "{{.ProjectName}}", config.ProjectName)
+	if strings.Contains(path, "{{.PackageName}}") {
+		packageName := config.PackageName
+		if packageName == "" {
+			packageName = PackageName(config.ProjectName)
+		}
+		path = strings.ReplaceAll(path, "{{.PackageName}}", packageName)
+	}
+	if strings.Contains(path, "{{.ServiceName}}") {
+		serviceName := config.ServiceName
+		if serviceName == "" {
+			serviceName = config.ServiceNames()[0]
+		}
+		path = strings.ReplaceAll(path, "{{.ServiceName}}", serviceName)
+	}
+	if strings.Contains(path, "{{.FeatureName}}") {
+		featureName := config.FeatureName
+		if featureName == "" {
+			featureName = DefaultFeatureName
+		}
+		path = strings.ReplaceAll(path, "{{.FeatureName}}", featureName)
+	}
 	return path
 }
 
-// generateGoMod creates a go.mod file with the appropriate dependencies
-func (g *Generator) generateGoMod(zipWriter *zip.Writer, config ProjectConfig) error {
-	goModPath := filepath.Join(config.ProjectName, "go.mod")
-	f, err := zipWriter.Create(goModPath)
-	if err != nil {
-		return err
-	}
-
+// generateGoMod creates a go.mod file requiring deps, followed by the
+// indirect requirements of deps known to indirectDependencies, at the versions
+// resolveModules selects
+func (g *Generator) generateGoMod(config ProjectConfig, deps map[string]string) []byte {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("module %s\n\n", config.Module))
-	buf.WriteString(fmt.Sprintf("go %s\n", config.GoVersion))
+	// Heroku's Go buildpack installs the module root unless told otherwise
+	if platformTarget(config) == "heroku" && config.Structure != "flat" {
+		buf.WriteString(fmt.Sprintf("// +heroku install ./cmd/%s\n\n", config.ServiceName))
+	}
+	goLine, toolchain := goDirectives(config.GoVersion)
+	buf.WriteString(fmt.Sprintf("go %s\n", goLine))
+	if toolchain != "" {
+		buf.WriteString(fmt.Sprintf("\ntoolchain %s\n", toolchain))
+	}
+
+	direct, indirect := resolveModules(deps)
 
-	// Collect dependencies
-	deps := g.getDependencies(config)
-	if len(deps) > 0 {
+	// Stdlib-only projects get no require block
+	if len(direct) > 0 {
 		buf.WriteString("\nrequire (\n")
-		for pkg, version := range deps {
-			buf.WriteString(fmt.Sprintf("\t%s %s\n", pkg, version))
+		for _, pkg := range sortedPackages(direct) {
+			buf.WriteString(fmt.Sprintf("\t%s %s\n", pkg, direct[pkg]))
 		}
 		buf.WriteString(")\n")
 	}
 
-	_, err = f.Write(buf.Bytes())
-	return err
+	// Requirements of those dependencies, as go mod tidy lists them
+	if len(indirect) > 0 {
+		buf.WriteString("\nrequire (\n")
+		for _, pkg := range sortedPackages(indirect) {
+			buf.WriteString(fmt.Sprintf("\t%s %s // indirect\n", pkg, indirect[pkg]))
+		}
+		buf.WriteString(")\n")
+	}
+
+	return buf.Bytes()
+}
+
+// generateGoSum creates a go.sum file for the modules generateGoMod requires
+// and their module graphs, from the embedded checksum tables. Modules without
+// a known checksum are omitted with a warning, as are the graphs of
+// dependencies resolved to a version moduleGraphs doesn't know; `go mod
+// download` fills those in on the first build.
+func generateGoSum(deps map[string]string) []byte {
+	direct, indirect := resolveModules(deps)
+	lines := make(map[string]bool)
+	for _, required := range []map[string]string{direct, indirect} {
+		for pkg, version := range required {
+			lines[pkg+" "+version] = true
+			lines[pkg+" "+version+"/go.mod"] = true
+			for _, line := range moduleGraphs[pkg+"@"+version] {
+				lines[line] = true
+			}
+		}
+	}
+	for pkg, version := range deps {
+		graph, ok := moduleGraphs[pkg+"@"+version]
+		if !ok {
+			log.Printf("Warning: no module graph for %s %s, go.sum will be incomplete", pkg, version)
+			continue
+		}
+		for _, line := range graph {
+			lines[line] = true
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, line := range sortedSumLines(lines) {
+		pkg, version, _ := strings.Cut(line, " ")
+		version, isGoMod := strings.CutSuffix(version, "/go.mod")
+		sum, ok := lookupChecksum(pkg, version)
+		if !ok {
+			if !isGoMod {
+				log.Printf("Warning: no checksum for %s %s, omitting from go.sum", pkg, version)
+			}
+			continue
+		}
+		hash := sum.Hash
+		if isGoMod {
+			hash = sum.ModHash
+		}
+		if hash == "" {
+			log.Printf("Warning: no checksum for %s, omitting from go.sum", line)
+			continue
+		}
+		buf.WriteString(fmt.Sprintf("%s %s\n", line, hash))
+	}
+
+	return buf.Bytes()
+}
+
+// sortedSumLines returns the "module version" keys of go.sum lines in the
+// order the go command writes them: by module path, then by version, with a
+// module's go.mod line after its content line
+func sortedSumLines(lines map[string]bool) []string {
+	keys := make([]string, 0, len(lines))
+	for line := range lines {
+		keys = append(keys, line)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		pi, vi, _ := strings.Cut(keys[i], " ")
+		pj, vj, _ := strings.Cut(keys[j], " ")
+		if pi != pj {
+			return pi < pj
+		}
+		if c := compareVersions(strings.TrimSuffix(vi, "/go.mod"), strings.TrimSuffix(vj, "/go.mod")); c != 0 {
+			return c < 0
+		}
+		return vi < vj
+	})
+	return keys
+}
+
+// goDirectives returns the go.mod "go" and "toolchain" values for a Go
+// version. A patch release keeps the language version at the .0 release and
+// recommends the patch release as the toolchain; otherwise toolchain is empty.
+// Versions that don't parse are written as given.
+func goDirectives(version string) (goLine, toolchain string) {
+	minor, patch, err := parseGoVersion(version)
+	if err != nil {
+		return version, ""
+	}
+	goLine = fmt.Sprintf("1.%d.0", minor)
+	if patch > 0 {
+		toolchain = fmt.Sprintf("go1.%d.%d", minor, patch)
+	}
+	return goLine, toolchain
+}
+
+// sortedPackages returns the module paths of deps in sorted order
+func sortedPackages(deps map[string]string) []string {
+	pkgs := make([]string, 0, len(deps))
+	for pkg := range deps {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	return pkgs
 }
 
 // getDependencies returns a map of package -> version based on config
-func (g *Generator) getDependencies(config ProjectConfig) map[string]string {
+func (g *Generator) getDependencies(ctx context.Context, config ProjectConfig) map[string]string {
 	deps := make(map[string]string)
 
-	// Router dependencies
-	switch config.Router {
-	case "chi":
-		deps["github.com/go-chi/chi/v5"] = "v5.0.11"
-	case "gin":
-		deps["github.com/gin-gonic/gin"] = "v1.9.1"
-	case "echo":
-		deps["github.com/labstack/echo/v4"] = "v4.11.4"
-	case "fiber":
-		deps["github.com/gofiber/fiber/v2"] = "v2.52.0"
+	// Router dependencies (CLI and gRPC projects don't serve HTTP)
+	if config.ProjectType != "cli" && config.ProjectType != "grpc" {
+		switch config.Router {
+		case "chi":
+			deps["github.com/go-chi/chi/v5"] = "v5.0.11"
+		case "gin":
+			deps["github.com/gin-gonic/gin"] = "v1.9.1"
+		case "echo":
+			deps["github.com/labstack/echo/v4"] = "v4.11.4"
+		case "fiber":
+			deps["github.com/gofiber/fiber/v2"] = "v2.52.0"
+		}
+	}
+
+	// CLI dependencies
+	if config.ProjectType == "cli" {
+		deps["github.com/spf13/cobra"] = "v1.8.0"
+	}
+
+	// gRPC dependencies
+	if config.ProjectType == "grpc" {
+		deps["google.golang.org/grpc"] = "v1.60.1"
+		deps["google.golang.org/protobuf"] = "v1.32.0"
+	}
+
+	// GraphQL dependencies; gqlparser is imported by the gqlgen generated code
+	if config.ProjectType == "graphql" {
+		deps["github.com/99designs/gqlgen"] = "v0.17.45"
+		deps["github.com/vektah/gqlparser/v2"] = "v2.5.11"
+	}
+
+	// Swagger UI for the generated OpenAPI spec
+	if config.UseSwaggerDocs() {
+		deps["github.com/swaggo/http-swagger"] = "v1.3.4"
+	}
+
+	// Fiber runs on fasthttp, so its WebSocket example uses the Fiber adapter
+	if config.UseWebSocket() && config.Router == "fiber" {
+		deps["github.com/gofiber/contrib/websocket"] = "v1.3.0"
+	}
+
+	// testcontainers runs the database of the integration test
+	if config.UseIntegrationTests() {
+		deps["github.com/testcontainers/testcontainers-go"] = "v0.28.0"
+	}
+
+	// Viper backs the config package when the viper config style is chosen
+	if config.UseViper() {
+		deps["github.com/spf13/viper"] = "v1.18.2"
+	}
+
+	// godotenv's autoload package reads .env from the entrypoint's imports
+	if config.UseDotenvLoader() {
+		deps["github.com/joho/godotenv"] = "v1.5.1"
 	}
 
 	// Logger dependencies
@@ -196,25 +1275,25 @@ func (g *Generator) getDependencies(config ProjectConfig) map[string]string {
 		deps["github.com/sirupsen/logrus"] = "v1.9.3"
 	}
 
-	// Database dependencies
-	if config.UseDatabase {
-		// Check if PostgreSQL is in dependencies
-		hasPostgres := false
-		for _, dep := range config.Dependencies {
-			if strings.Contains(strings.ToLower(dep), "postgres") || strings.Contains(strings.ToLower(dep), "pgx") {
-				hasPostgres = true
-				deps["github.com/jackc/pgx/v5"] = "v5.5.1"
-				break
-			}
-		}
-		if !hasPostgres {
-			// Default to pgx if database is enabled
+	// Database driver (only the selected one)
+	if config.UseSQLDatabase() {
+		switch config.Database {
+		case "mysql":
+			deps["github.com/go-sql-driver/mysql"] = "v1.7.1"
+		case "sqlite":
+			deps["github.com/mattn/go-sqlite3"] = "v1.14.19"
+		default:
 			deps["github.com/jackc/pgx/v5"] = "v5.5.1"
 		}
 	}
 
-	// Add UUID for hexagonal architecture (used in repository)
-	if config.Structure == "hexagonal" {
+	// golang-migrate, which applies the SQL migrations in migrations/
+	if config.UseSQLDatabase() {
+		deps["github.com/golang-migrate/migrate/v4"] = "v4.17.0"
+	}
+
+	// Add UUID for hexagonal and clean architecture (used in repository)
+	if config.Structure == "hexagonal" || config.Structure == "clean" {
 		deps["github.com/google/uuid"] = "v1.5.0"
 	}
 
@@ -228,70 +1307,52 @@ func (g *Generator) getDependencies(config ProjectConfig) map[string]string {
 		deps["github.com/golang-jwt/jwt/v5"] = "v5.2.0"
 	}
 
-	// Process additional dependencies from the UI
+	// OpenTelemetry SDK, OTLP exporter and the instrumentation for the router
+	if useTracing(config) {
+		deps["go.opentelemetry.io/otel/sdk"] = "v1.22.0"
+		deps["go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"] = "v1.22.0"
+		switch {
+		case config.Router == "gin":
+			deps["go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"] = "v0.47.0"
+		case config.Router == "echo":
+			deps["go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"] = "v0.47.0"
+		case config.Router == "fiber" && (config.Structure == "standard" || config.Structure == ""):
+			deps["github.com/gofiber/contrib/otelfiber"] = "v1.0.10"
+		default:
+			deps["go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"] = "v0.47.0"
+		}
+	}
+
+	// Process additional dependencies from the UI (by name or package path)
+	pinned := make(map[string]string)
 	for _, dep := range config.Dependencies {
-		// Map dependency names to packages
-		switch dep {
-		case "Chi Router":
-			deps["github.com/go-chi/chi/v5"] = "v5.0.11"
-		case "Gin Web Framework":
-			deps["github.com/gin-gonic/gin"] = "v1.9.1"
-		case "Echo":
-			deps["github.com/labstack/echo/v4"] = "v4.11.4"
-		case "Fiber":
-			deps["github.com/gofiber/fiber/v2"] = "v2.52.0"
-		case "Gorilla Mux":
-			deps["github.com/gorilla/mux"] = "v1.8.1"
-		case "Templ":
-			deps["github.com/a-h/templ"] = "v0.2.543"
-		case "Pongo2":
-			deps["github.com/flosch/pongo2/v6"] = "v6.0.0"
-		case "PostgreSQL Driver (pgx)":
-			deps["github.com/jackc/pgx/v5"] = "v5.5.1"
-		case "MySQL Driver":
-			deps["github.com/go-sql-driver/mysql"] = "v1.7.1"
-		case "GORM":
-			deps["gorm.io/gorm"] = "v1.25.5"
-		case "sqlx":
-			deps["github.com/jmoiron/sqlx"] = "v1.3.5"
-		case "SQLite Driver":
-			deps["github.com/mattn/go-sqlite3"] = "v1.14.19"
-		case "Redis Client (go-redis)":
-			deps["github.com/redis/go-redis/v9"] = "v9.4.0"
-		case "MongoDB Driver":
-			deps["go.mongodb.org/mongo-driver"] = "v1.13.1"
-		case "BadgerDB":
-			deps["github.com/dgraph-io/badger/v4"] = "v4.2.0"
-		case "Zerolog":
-			deps["github.com/rs/zerolog"] = "v1.32.0"
-		case "Zap":
-			deps["go.uber.org/zap"] = "v1.26.0"
-		case "Logrus":
-			deps["github.com/sirupsen/logrus"] = "v1.9.3"
-		case "Prometheus Client":
-			deps["github.com/prometheus/client_golang"] = "v1.18.0"
-		case "OpenTelemetry":
-			deps["go.opentelemetry.io/otel"] = "v1.22.0"
-		case "Jaeger Client":
-			deps["github.com/jaegertracing/jaeger-client-go"] = "v2.30.0+incompatible"
-		case "RabbitMQ Client":
-			deps["github.com/rabbitmq/amqp091-go"] = "v1.9.0"
-		case "Kafka Client (Sarama)":
-			deps["github.com/IBM/sarama"] = "v1.42.2"
-		case "NATS":
-			deps["github.com/nats-io/nats.go"] = "v1.31.0"
-		case "Gorilla WebSocket":
-			deps["github.com/gorilla/websocket"] = "v1.5.1"
-		case "JWT-Go":
-			deps["github.com/golang-jwt/jwt/v5"] = "v5.2.0"
-		case "Testify":
-			deps["github.com/stretchr/testify"] = "v1.8.4"
-		case "GoMock":
-			deps["go.uber.org/mock"] = "v0.4.0"
-		case "Ginkgo":
-			deps["github.com/onsi/ginkgo/v2"] = "v2.15.0"
+		d, ok := lookupDependency(dep)
+		if !ok {
+			log.Printf("Warning: dependency %q is not in the catalog, skipping", dep)
+			continue
+		}
+		if d.Stdlib {
+			continue
+		}
+		deps[d.Package] = d.Version
+
+		if version, ok := config.DependencyVersions[dep]; ok && version != "" {
+			if err := ValidateVersion(version); err != nil {
+				log.Printf("Warning: ignoring version for %s: %v", d.Package, err)
+				continue
+			}
+			pinned[d.Package] = version
 		}
 	}
 
+	if config.ResolveLatest && !config.Offline {
+		g.versions.resolve(ctx, deps)
+	}
+
+	// Versions supplied by the user win over both the catalog and the proxy
+	for pkg, version := range pinned {
+		deps[pkg] = version
+	}
+
 	return deps
 }
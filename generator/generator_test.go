@@ -0,0 +1,199 @@
+package generator
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// newTestGenerator returns a generator rendering the templates of the repository
+func newTestGenerator() *Generator {
+	return New(embed.FS{}, os.DirFS("../templates"))
+}
+
+// testConfig returns a minimal valid configuration of structure
+func testConfig(structure, projectType string) ProjectConfig {
+	return ProjectConfig{ProjectName: "sample", Module: "example.com/sample", GoVersion: "1.22.0",
+		Structure: structure, ProjectType: projectType, Offline: true}
+}
+
+// templatesWithout copies the templates of the repository into memory,
+// leaving out the given paths
+func templatesWithout(t *testing.T, skip ...string) fstest.MapFS {
+	t.Helper()
+	fsys := fstest.MapFS{}
+	templates := os.DirFS("../templates")
+	err := fs.WalkDir(templates, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		for _, s := range skip {
+			if path == s {
+				return nil
+			}
+		}
+		data, err := fs.ReadFile(templates, path)
+		if err != nil {
+			return err
+		}
+		fsys[path] = &fstest.MapFile{Data: data}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fsys
+}
+
+func TestRenderFilesMissingTemplate(t *testing.T) {
+	g := New(embed.FS{}, templatesWithout(t, "standard/Makefile.tmpl", "standard/gitignore.tmpl"))
+	_, err := g.RenderFiles(testConfig("standard", "rest-api"))
+	if err == nil {
+		t.Fatal("RenderFiles succeeded without standard/Makefile.tmpl")
+	}
+	for _, path := range []string{"standard/Makefile.tmpl", "standard/gitignore.tmpl"} {
+		if !strings.Contains(err.Error(), path) {
+			t.Errorf("error %q does not name %s", err, path)
+		}
+	}
+}
+
+func TestRenderFilesSkipsMissingTemplateOfUnusedMapping(t *testing.T) {
+	// The Dockerfile is only rendered with use_docker
+	g := New(embed.FS{}, templatesWithout(t, "standard/Dockerfile.tmpl"))
+	if _, err := g.RenderFiles(testConfig("standard", "rest-api")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateTemplatesMissingTemplate(t *testing.T) {
+	// Overlays only override templates; every mapping needs an embedded one
+	err := New(embed.FS{}, os.DirFS("../templates")).ValidateTemplates()
+	if err == nil || !strings.Contains(err.Error(), "standard/Makefile.tmpl") {
+		t.Errorf("ValidateTemplates() = %v, want an error naming standard/Makefile.tmpl", err)
+	}
+}
+
+func TestOverlays(t *testing.T) {
+	base := os.DirFS("../templates")
+	first := fstest.MapFS{
+		"standard/gitignore.tmpl":   {Data: []byte("first {{.ProjectName}}\n")},
+		"standard/env.example.tmpl": {Data: []byte("FIRST=1\n")},
+		"standard/unmapped.tmpl":    {Data: []byte("never rendered\n")},
+	}
+	second := fstest.MapFS{
+		"standard/gitignore.tmpl": {Data: []byte("second {{.ProjectName}}\n")},
+	}
+	g := New(embed.FS{}, base, first, second)
+	config := testConfig("standard", "rest-api")
+
+	tests := []struct{ path, want string }{
+		{".gitignore", "second sample\n"}, // the last overlay wins
+		{".env.example", "FIRST=1\n"},
+	}
+	for _, tt := range tests {
+		f, err := g.RenderFile(config, tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(f.Content) != tt.want {
+			t.Errorf("%s = %q, want %q", tt.path, f.Content, tt.want)
+		}
+	}
+
+	files, err := g.RenderFiles(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if strings.Contains(string(f.Content), "never rendered") {
+			t.Errorf("%s rendered a template no mapping refers to", f.Path)
+		}
+	}
+}
+
+func TestOverlayParseError(t *testing.T) {
+	broken := fstest.MapFS{"standard/gitignore.tmpl": {Data: []byte("{{if}}\n")}}
+	g := New(embed.FS{}, os.DirFS("../templates"), broken)
+	_, err := g.RenderFiles(testConfig("standard", "rest-api"))
+	if err == nil || !strings.Contains(err.Error(), "standard/gitignore.tmpl") {
+		t.Errorf("RenderFiles() = %v, want a parse error naming standard/gitignore.tmpl", err)
+	}
+}
+
+// benchConfig is a chi/zap REST API with Docker, CI, config and a database,
+// which touches most templates of each layout
+func benchConfig(structure string) ProjectConfig {
+	return ProjectConfig{ProjectName: "bench", Module: "example.com/bench", GoVersion: "1.22.0",
+		Structure: structure, ProjectType: "rest-api", Router: "chi", Logger: "zap",
+		UseLogger: true, UseConfig: true, UseDocker: true, UseGitHub: true, UseDatabase: true}
+}
+
+// BenchmarkGenerate generates the same project repeatedly for every structure.
+//
+// Caching the parsed templates on the Generator instead of parsing every
+// template on each call, measured with the cache switched off for "before"
+// (-benchtime 300x):
+//
+//	            before                after
+//	standard    780 KB  10128 allocs   248 KB  2422 allocs
+//	flat        383 KB   4740 allocs   159 KB  1445 allocs
+//	feature     824 KB  10845 allocs   246 KB  2487 allocs
+//	hexagonal   749 KB   7917 allocs   260 KB  2171 allocs
+//	clean       731 KB   7804 allocs   254 KB  2135 allocs
+//
+// Rendering into pooled buffers and caching gofmt output, which repeated
+// configurations hit on every file, measured the same way:
+//
+//	            before                after
+//	standard    436 KB  6910 allocs   248 KB  2422 allocs
+//	flat        190 KB  2142 allocs   159 KB  1445 allocs
+//	feature     495 KB  8784 allocs   246 KB  2487 allocs
+//	hexagonal   570 KB  9731 allocs   260 KB  2171 allocs
+//	clean       572 KB  9902 allocs   254 KB  2135 allocs
+func BenchmarkGenerate(b *testing.B) {
+	g := newTestGenerator()
+	for _, structure := range GetOptions().Structures {
+		b.Run(structure, func(b *testing.B) {
+			config := benchConfig(structure)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := g.Generate(config); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkGenerateNewModule generates a project with a different module path
+// on each run, so the files importing the module miss the gofmt cache.
+//
+// Rendering into pooled buffers and caching gofmt output, measured with both
+// switched off for "before" (-benchtime 300x):
+//
+//	            before                after
+//	standard    437 KB  6912 allocs   331 KB  4250 allocs
+//	flat        190 KB  2145 allocs   159 KB  1446 allocs
+//	feature     495 KB  8787 allocs   300 KB  3766 allocs
+//	hexagonal   570 KB  9732 allocs   478 KB  7367 allocs
+//	clean       580 KB  9904 allocs   486 KB  7514 allocs
+func BenchmarkGenerateNewModule(b *testing.B) {
+	g := newTestGenerator()
+	for _, structure := range GetOptions().Structures {
+		b.Run(structure, func(b *testing.B) {
+			config := benchConfig(structure)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				config.Module = fmt.Sprintf("example.com/bench%d", i)
+				if _, err := g.Generate(config); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,571 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// indirectDependencies maps "module@version" of a direct dependency to the
+// modules it requires that `go mod tidy` adds to go.mod as // indirect. Keys
+// must match the versions pinned in getDependencies: a dependency resolved to
+// another version, by ResolveLatest or DependencyVersions, gets no indirect
+// entries, so a stale list is never written. The lists come from `go mod tidy`
+// in a module importing the packages the templates use from the dependency,
+// like those of moduleGraphs.
+var indirectDependencies = map[string]map[string]string{
+	"github.com/99designs/gqlgen@v0.17.45": {
+		"github.com/agnivade/levenshtein":    "v1.1.1",
+		"github.com/cpuguy83/go-md2man/v2":   "v2.0.2",
+		"github.com/google/uuid":             "v1.6.0",
+		"github.com/gorilla/websocket":       "v1.5.0",
+		"github.com/hashicorp/golang-lru/v2": "v2.0.7",
+		"github.com/mitchellh/mapstructure":  "v1.5.0",
+		"github.com/russross/blackfriday/v2": "v2.1.0",
+		"github.com/sosodev/duration":        "v1.2.0",
+		"github.com/urfave/cli/v2":           "v2.27.1",
+		"github.com/vektah/gqlparser/v2":     "v2.5.11",
+		"github.com/xrash/smetrics":          "v0.0.0-20201216005158-039620a65673",
+		"golang.org/x/mod":                   "v0.16.0",
+		"golang.org/x/text":                  "v0.14.0",
+		"golang.org/x/tools":                 "v0.19.0",
+		"gopkg.in/yaml.v3":                   "v3.0.1",
+	},
+	"github.com/IBM/sarama@v1.42.2": {
+		"github.com/davecgh/go-spew":          "v1.1.1",
+		"github.com/eapache/go-resiliency":    "v1.5.0",
+		"github.com/eapache/go-xerial-snappy": "v0.0.0-20230731223053-c322873962e3",
+		"github.com/eapache/queue":            "v1.1.0",
+		"github.com/golang/snappy":            "v0.0.4",
+		"github.com/hashicorp/errwrap":        "v1.0.0",
+		"github.com/hashicorp/go-multierror":  "v1.1.1",
+		"github.com/hashicorp/go-uuid":        "v1.0.3",
+		"github.com/jcmturner/aescts/v2":      "v2.0.0",
+		"github.com/jcmturner/dnsutils/v2":    "v2.0.0",
+		"github.com/jcmturner/gofork":         "v1.7.6",
+		"github.com/jcmturner/gokrb5/v8":      "v8.4.4",
+		"github.com/jcmturner/rpc/v2":         "v2.0.3",
+		"github.com/klauspost/compress":       "v1.16.7",
+		"github.com/pierrec/lz4/v4":           "v4.1.21",
+		"github.com/rcrowley/go-metrics":      "v0.0.0-20201227073835-cf1acfcdf475",
+		"golang.org/x/crypto":                 "v0.19.0",
+		"golang.org/x/net":                    "v0.21.0",
+	},
+	"github.com/apache/pulsar-client-go@v0.12.0": {
+		"github.com/99designs/go-keychain":                   "v0.0.0-20191008050251-8e49817e8af4",
+		"github.com/99designs/keyring":                       "v1.2.1",
+		"github.com/AthenZ/athenz":                           "v1.10.39",
+		"github.com/DataDog/zstd":                            "v1.5.0",
+		"github.com/ardielle/ardielle-go":                    "v1.5.2",
+		"github.com/beorn7/perks":                            "v1.0.1",
+		"github.com/bits-and-blooms/bitset":                  "v1.4.0",
+		"github.com/bmizerany/perks":                         "v0.0.0-20141205001514-d9a9656a3a4b",
+		"github.com/cespare/xxhash/v2":                       "v2.1.1",
+		"github.com/danieljoos/wincred":                      "v1.1.2",
+		"github.com/davecgh/go-spew":                         "v1.1.1",
+		"github.com/dvsekhvalnov/jose2go":                    "v1.6.0",
+		"github.com/fsnotify/fsnotify":                       "v1.4.9",
+		"github.com/godbus/dbus":                             "v0.0.0-20190726142602-4481cbc300e2",
+		"github.com/golang-jwt/jwt":                          "v3.2.1+incompatible",
+		"github.com/golang/protobuf":                         "v1.5.2",
+		"github.com/golang/snappy":                           "v0.0.1",
+		"github.com/google/uuid":                             "v1.1.2",
+		"github.com/gsterjov/go-libsecret":                   "v0.0.0-20161001094733-a6f4afe4910c",
+		"github.com/hashicorp/errwrap":                       "v1.0.0",
+		"github.com/hashicorp/go-multierror":                 "v1.1.1",
+		"github.com/inconshreveable/mousetrap":               "v1.0.1",
+		"github.com/klauspost/compress":                      "v1.14.4",
+		"github.com/konsorten/go-windows-terminal-sequences": "v1.0.3",
+		"github.com/linkedin/goavro/v2":                      "v2.9.8",
+		"github.com/matttproud/golang_protobuf_extensions":   "v1.0.1",
+		"github.com/mtibben/percent":                         "v0.2.1",
+		"github.com/nxadm/tail":                              "v1.4.8",
+		"github.com/onsi/ginkgo":                             "v1.16.5",
+		"github.com/onsi/gomega":                             "v1.19.0",
+		"github.com/opentracing/opentracing-go":              "v1.2.0",
+		"github.com/pierrec/lz4":                             "v2.0.5+incompatible",
+		"github.com/pkg/errors":                              "v0.9.1",
+		"github.com/pmezard/go-difflib":                      "v1.0.0",
+		"github.com/prometheus/client_golang":                "v1.11.1",
+		"github.com/prometheus/client_model":                 "v0.2.0",
+		"github.com/prometheus/common":                       "v0.26.0",
+		"github.com/prometheus/procfs":                       "v0.6.0",
+		"github.com/sirupsen/logrus":                         "v1.6.0",
+		"github.com/spaolacci/murmur3":                       "v1.1.0",
+		"github.com/spf13/cobra":                             "v1.6.1",
+		"github.com/spf13/pflag":                             "v1.0.5",
+		"github.com/stretchr/objx":                           "v0.4.0",
+		"github.com/stretchr/testify":                        "v1.8.0",
+		"go.uber.org/atomic":                                 "v1.7.0",
+		"golang.org/x/mod":                                   "v0.8.0",
+		"golang.org/x/net":                                   "v0.17.0",
+		"golang.org/x/oauth2":                                "v0.0.0-20210402161424-2e8d93401602",
+		"golang.org/x/sys":                                   "v0.13.0",
+		"golang.org/x/term":                                  "v0.13.0",
+		"golang.org/x/text":                                  "v0.13.0",
+		"golang.org/x/time":                                  "v0.0.0-20191024005414-555d28b269f0",
+		"google.golang.org/appengine":                        "v1.6.7",
+		"google.golang.org/protobuf":                         "v1.30.0",
+		"gopkg.in/tomb.v1":                                   "v1.0.0-20141024135613-dd632973f1e7",
+		"gopkg.in/yaml.v2":                                   "v2.4.0",
+		"gopkg.in/yaml.v3":                                   "v3.0.1",
+	},
+	"github.com/aws/aws-sdk-go-v2/service/sqs@v1.29.7": {
+		"github.com/aws/aws-sdk-go-v2":                        "v1.24.1",
+		"github.com/aws/aws-sdk-go-v2/internal/configsources": "v1.2.10",
+		"github.com/aws/aws-sdk-go-v2/internal/endpoints/v2":  "v2.5.10",
+		"github.com/aws/smithy-go":                            "v1.19.0",
+		"github.com/google/go-cmp":                            "v0.5.8",
+	},
+	"github.com/casbin/casbin/v2@v2.82.0": {
+		"github.com/casbin/govaluate": "v1.1.0",
+		"github.com/golang/mock":      "v1.4.4",
+	},
+	"github.com/charmbracelet/bubbletea@v0.25.0": {
+		"github.com/aymanbagabas/go-osc52/v2": "v2.0.1",
+		"github.com/containerd/console":       "v1.0.4-0.20230313162750-1ae8d489ac81",
+		"github.com/lucasb-eyer/go-colorful":  "v1.2.0",
+		"github.com/mattn/go-isatty":          "v0.0.18",
+		"github.com/mattn/go-localereader":    "v0.0.1",
+		"github.com/mattn/go-runewidth":       "v0.0.14",
+		"github.com/muesli/ansi":              "v0.0.0-20211018074035-2e021307bc4b",
+		"github.com/muesli/cancelreader":      "v0.2.2",
+		"github.com/muesli/reflow":            "v0.3.0",
+		"github.com/muesli/termenv":           "v0.15.2",
+		"github.com/rivo/uniseg":              "v0.2.0",
+		"golang.org/x/sync":                   "v0.1.0",
+		"golang.org/x/sys":                    "v0.7.0",
+		"golang.org/x/term":                   "v0.6.0",
+		"golang.org/x/text":                   "v0.3.8",
+	},
+	"github.com/dgraph-io/badger/v4@v4.2.0": {
+		"github.com/cespare/xxhash/v2":   "v2.2.0",
+		"github.com/dgraph-io/ristretto": "v0.1.1",
+		"github.com/dustin/go-humanize":  "v1.0.0",
+		"github.com/gogo/protobuf":       "v1.3.2",
+		"github.com/golang/glog":         "v1.0.0",
+		"github.com/golang/groupcache":   "v0.0.0-20190702054246-869f871628b6",
+		"github.com/golang/protobuf":     "v1.5.2",
+		"github.com/golang/snappy":       "v0.0.3",
+		"github.com/google/flatbuffers":  "v1.12.1",
+		"github.com/klauspost/compress":  "v1.12.3",
+		"github.com/pkg/errors":          "v0.9.1",
+		"go.opencensus.io":               "v0.22.5",
+		"golang.org/x/net":               "v0.7.0",
+		"golang.org/x/sys":               "v0.5.0",
+		"google.golang.org/protobuf":     "v1.28.1",
+		"gopkg.in/yaml.v3":               "v3.0.1",
+	},
+	"github.com/gin-gonic/gin@v1.9.1": {
+		"github.com/bytedance/sonic":                    "v1.9.1",
+		"github.com/chenzhuoyu/base64x":                 "v0.0.0-20221115062448-fe3a3abad311",
+		"github.com/gabriel-vasile/mimetype":            "v1.4.2",
+		"github.com/gin-contrib/sse":                    "v0.1.0",
+		"github.com/go-playground/locales":              "v0.14.1",
+		"github.com/go-playground/universal-translator": "v0.18.1",
+		"github.com/go-playground/validator/v10":        "v10.14.0",
+		"github.com/goccy/go-json":                      "v0.10.2",
+		"github.com/json-iterator/go":                   "v1.1.12",
+		"github.com/klauspost/cpuid/v2":                 "v2.2.4",
+		"github.com/leodido/go-urn":                     "v1.2.4",
+		"github.com/mattn/go-isatty":                    "v0.0.19",
+		"github.com/modern-go/concurrent":               "v0.0.0-20180306012644-bacd9c7ef1dd",
+		"github.com/modern-go/reflect2":                 "v1.0.2",
+		"github.com/pelletier/go-toml/v2":               "v2.0.8",
+		"github.com/twitchyliquid64/golang-asm":         "v0.15.1",
+		"github.com/ugorji/go/codec":                    "v1.2.11",
+		"golang.org/x/arch":                             "v0.3.0",
+		"golang.org/x/crypto":                           "v0.9.0",
+		"golang.org/x/net":                              "v0.10.0",
+		"golang.org/x/sys":                              "v0.8.0",
+		"golang.org/x/text":                             "v0.9.0",
+		"google.golang.org/protobuf":                    "v1.30.0",
+		"gopkg.in/yaml.v3":                              "v3.0.1",
+	},
+	"github.com/gofiber/contrib/otelfiber@v1.0.10": {
+		"github.com/andybalholm/brotli":     "v1.0.5",
+		"github.com/go-logr/logr":           "v1.2.4",
+		"github.com/go-logr/stdr":           "v1.2.2",
+		"github.com/gofiber/fiber/v2":       "v2.48.0",
+		"github.com/google/uuid":            "v1.3.0",
+		"github.com/klauspost/compress":     "v1.16.3",
+		"github.com/mattn/go-colorable":     "v0.1.13",
+		"github.com/mattn/go-isatty":        "v0.0.19",
+		"github.com/mattn/go-runewidth":     "v0.0.14",
+		"github.com/rivo/uniseg":            "v0.4.3",
+		"github.com/valyala/bytebufferpool": "v1.0.0",
+		"github.com/valyala/fasthttp":       "v1.48.0",
+		"github.com/valyala/tcplisten":      "v1.0.0",
+		"go.opentelemetry.io/contrib":       "v1.17.0",
+		"go.opentelemetry.io/otel":          "v1.16.0",
+		"go.opentelemetry.io/otel/metric":   "v1.16.0",
+		"go.opentelemetry.io/otel/trace":    "v1.16.0",
+		"golang.org/x/sys":                  "v0.10.0",
+	},
+	"github.com/gofiber/contrib/websocket@v1.3.0": {
+		"github.com/andybalholm/brotli":     "v1.0.5",
+		"github.com/fasthttp/websocket":     "v1.5.7",
+		"github.com/gofiber/fiber/v2":       "v2.51.0",
+		"github.com/google/uuid":            "v1.4.0",
+		"github.com/klauspost/compress":     "v1.17.3",
+		"github.com/mattn/go-colorable":     "v0.1.13",
+		"github.com/mattn/go-isatty":        "v0.0.20",
+		"github.com/mattn/go-runewidth":     "v0.0.15",
+		"github.com/rivo/uniseg":            "v0.2.0",
+		"github.com/savsgio/gotils":         "v0.0.0-20230208104028-c358bd845dee",
+		"github.com/valyala/bytebufferpool": "v1.0.0",
+		"github.com/valyala/fasthttp":       "v1.51.0",
+		"github.com/valyala/tcplisten":      "v1.0.0",
+		"golang.org/x/net":                  "v0.18.0",
+		"golang.org/x/sys":                  "v0.14.0",
+	},
+	"github.com/gofiber/fiber/v2@v2.52.0": {
+		"github.com/andybalholm/brotli":     "v1.0.5",
+		"github.com/google/uuid":            "v1.5.0",
+		"github.com/klauspost/compress":     "v1.17.0",
+		"github.com/mattn/go-colorable":     "v0.1.13",
+		"github.com/mattn/go-isatty":        "v0.0.20",
+		"github.com/mattn/go-runewidth":     "v0.0.15",
+		"github.com/rivo/uniseg":            "v0.2.0",
+		"github.com/valyala/bytebufferpool": "v1.0.0",
+		"github.com/valyala/fasthttp":       "v1.51.0",
+		"github.com/valyala/tcplisten":      "v1.0.0",
+		"golang.org/x/sys":                  "v0.15.0",
+	},
+	"github.com/golang-migrate/migrate/v4@v4.17.0": {
+		"github.com/hashicorp/errwrap":       "v1.1.0",
+		"github.com/hashicorp/go-multierror": "v1.1.1",
+		"go.uber.org/atomic":                 "v1.7.0",
+	},
+	"github.com/gorilla/websocket@v1.5.1": {
+		"golang.org/x/net": "v0.17.0",
+	},
+	"github.com/jackc/pgx/v5@v5.5.1": {
+		"github.com/jackc/pgpassfile":    "v1.0.0",
+		"github.com/jackc/pgservicefile": "v0.0.0-20221227161230-091c0ba34f0a",
+		"github.com/jackc/puddle/v2":     "v2.2.1",
+		"golang.org/x/crypto":            "v0.9.0",
+		"golang.org/x/sync":              "v0.1.0",
+		"golang.org/x/text":              "v0.9.0",
+	},
+	"github.com/labstack/echo/v4@v4.11.4": {
+		"github.com/golang-jwt/jwt":         "v3.2.2+incompatible",
+		"github.com/labstack/gommon":        "v0.4.2",
+		"github.com/mattn/go-colorable":     "v0.1.13",
+		"github.com/mattn/go-isatty":        "v0.0.20",
+		"github.com/valyala/bytebufferpool": "v1.0.0",
+		"github.com/valyala/fasttemplate":   "v1.2.2",
+		"golang.org/x/crypto":               "v0.17.0",
+		"golang.org/x/net":                  "v0.19.0",
+		"golang.org/x/sys":                  "v0.15.0",
+		"golang.org/x/text":                 "v0.14.0",
+		"golang.org/x/time":                 "v0.5.0",
+	},
+	"github.com/nats-io/nats.go@v1.31.0": {
+		"github.com/klauspost/compress": "v1.17.0",
+		"github.com/nats-io/nkeys":      "v0.4.5",
+		"github.com/nats-io/nuid":       "v1.0.1",
+		"golang.org/x/crypto":           "v0.6.0",
+		"golang.org/x/sys":              "v0.5.0",
+	},
+	"github.com/onsi/ginkgo/v2@v2.15.0": {
+		"github.com/go-logr/logr":       "v1.3.0",
+		"github.com/go-task/slim-sprig": "v0.0.0-20230315185526-52ccab3ef572",
+		"github.com/google/pprof":       "v0.0.0-20210407192527-94a9f03dee38",
+		"golang.org/x/net":              "v0.19.0",
+		"golang.org/x/sys":              "v0.15.0",
+		"golang.org/x/tools":            "v0.16.1",
+	},
+	"github.com/prometheus/client_golang@v1.18.0": {
+		"github.com/beorn7/perks":                             "v1.0.1",
+		"github.com/cespare/xxhash/v2":                        "v2.2.0",
+		"github.com/matttproud/golang_protobuf_extensions/v2": "v2.0.0",
+		"github.com/prometheus/client_model":                  "v0.5.0",
+		"github.com/prometheus/common":                        "v0.45.0",
+		"github.com/prometheus/procfs":                        "v0.12.0",
+		"golang.org/x/sys":                                    "v0.15.0",
+		"google.golang.org/protobuf":                          "v1.31.0",
+	},
+	"github.com/redis/go-redis/v9@v9.4.0": {
+		"github.com/cespare/xxhash/v2":     "v2.2.0",
+		"github.com/dgryski/go-rendezvous": "v0.0.0-20200823014737-9f7001d12a5f",
+	},
+	"github.com/rs/zerolog@v1.32.0": {
+		"github.com/mattn/go-colorable": "v0.1.13",
+		"github.com/mattn/go-isatty":    "v0.0.19",
+		"golang.org/x/sys":              "v0.12.0",
+	},
+	"github.com/segmentio/kafka-go@v0.4.47": {
+		"github.com/klauspost/compress": "v1.15.9",
+		"github.com/pierrec/lz4/v4":     "v4.1.15",
+	},
+	"github.com/sirupsen/logrus@v1.9.3": {
+		"golang.org/x/sys": "v0.0.0-20220715151400-c0bba94af5f8",
+	},
+	"github.com/spf13/cobra@v1.8.0": {
+		"github.com/inconshreveable/mousetrap": "v1.1.0",
+		"github.com/spf13/pflag":               "v1.0.5",
+	},
+	"github.com/spf13/viper@v1.18.2": {
+		"github.com/fsnotify/fsnotify":       "v1.7.0",
+		"github.com/hashicorp/hcl":           "v1.0.0",
+		"github.com/magiconair/properties":   "v1.8.7",
+		"github.com/mitchellh/mapstructure":  "v1.5.0",
+		"github.com/pelletier/go-toml/v2":    "v2.1.0",
+		"github.com/sagikazarmark/locafero":  "v0.4.0",
+		"github.com/sagikazarmark/slog-shim": "v0.1.0",
+		"github.com/sourcegraph/conc":        "v0.3.0",
+		"github.com/spf13/afero":             "v1.11.0",
+		"github.com/spf13/cast":              "v1.6.0",
+		"github.com/spf13/pflag":             "v1.0.5",
+		"github.com/subosito/gotenv":         "v1.6.0",
+		"go.uber.org/atomic":                 "v1.9.0",
+		"go.uber.org/multierr":               "v1.9.0",
+		"golang.org/x/exp":                   "v0.0.0-20230905200255-921286631fa9",
+		"golang.org/x/sys":                   "v0.15.0",
+		"golang.org/x/text":                  "v0.14.0",
+		"gopkg.in/ini.v1":                    "v1.67.0",
+		"gopkg.in/yaml.v3":                   "v3.0.1",
+	},
+	"github.com/stretchr/testify@v1.8.4": {
+		"github.com/davecgh/go-spew":    "v1.1.1",
+		"github.com/pmezard/go-difflib": "v1.0.0",
+		"github.com/stretchr/objx":      "v0.5.0",
+		"gopkg.in/yaml.v3":              "v3.0.1",
+	},
+	"github.com/swaggo/http-swagger@v1.3.4": {
+		"github.com/KyleBanks/depth":          "v1.2.1",
+		"github.com/go-openapi/jsonpointer":   "v0.19.5",
+		"github.com/go-openapi/jsonreference": "v0.20.0",
+		"github.com/go-openapi/spec":          "v0.20.6",
+		"github.com/go-openapi/swag":          "v0.19.15",
+		"github.com/josharian/intern":         "v1.0.0",
+		"github.com/mailru/easyjson":          "v0.7.6",
+		"github.com/swaggo/files":             "v0.0.0-20220610200504-28940afbdbfe",
+		"github.com/swaggo/swag":              "v1.8.1",
+		"golang.org/x/net":                    "v0.7.0",
+		"golang.org/x/sys":                    "v0.5.0",
+		"golang.org/x/tools":                  "v0.1.12",
+		"gopkg.in/yaml.v2":                    "v2.4.0",
+	},
+	"github.com/testcontainers/testcontainers-go@v0.28.0": {
+		"dario.cat/mergo":                                               "v1.0.0",
+		"github.com/Azure/go-ansiterm":                                  "v0.0.0-20210617225240-d185dfc1b5a1",
+		"github.com/Microsoft/go-winio":                                 "v0.6.1",
+		"github.com/Microsoft/hcsshim":                                  "v0.11.4",
+		"github.com/cenkalti/backoff/v4":                                "v4.2.1",
+		"github.com/containerd/containerd":                              "v1.7.12",
+		"github.com/containerd/log":                                     "v0.1.0",
+		"github.com/cpuguy83/dockercfg":                                 "v0.3.1",
+		"github.com/davecgh/go-spew":                                    "v1.1.1",
+		"github.com/distribution/reference":                             "v0.5.0",
+		"github.com/docker/docker":                                      "v25.0.2+incompatible",
+		"github.com/docker/go-connections":                              "v0.5.0",
+		"github.com/docker/go-units":                                    "v0.5.0",
+		"github.com/felixge/httpsnoop":                                  "v1.0.3",
+		"github.com/go-logr/logr":                                       "v1.2.4",
+		"github.com/go-logr/stdr":                                       "v1.2.2",
+		"github.com/go-ole/go-ole":                                      "v1.2.6",
+		"github.com/gogo/protobuf":                                      "v1.3.2",
+		"github.com/golang/protobuf":                                    "v1.5.3",
+		"github.com/google/uuid":                                        "v1.6.0",
+		"github.com/klauspost/compress":                                 "v1.16.0",
+		"github.com/kr/pretty":                                          "v0.3.0",
+		"github.com/kr/text":                                            "v0.2.0",
+		"github.com/lufia/plan9stats":                                   "v0.0.0-20211012122336-39d0f177ccd0",
+		"github.com/magiconair/properties":                              "v1.8.7",
+		"github.com/moby/patternmatcher":                                "v0.6.0",
+		"github.com/moby/sys/sequential":                                "v0.5.0",
+		"github.com/moby/sys/user":                                      "v0.1.0",
+		"github.com/moby/term":                                          "v0.5.0",
+		"github.com/morikuni/aec":                                       "v1.0.0",
+		"github.com/opencontainers/go-digest":                           "v1.0.0",
+		"github.com/opencontainers/image-spec":                          "v1.1.0-rc5",
+		"github.com/pkg/errors":                                         "v0.9.1",
+		"github.com/pmezard/go-difflib":                                 "v1.0.0",
+		"github.com/power-devops/perfstat":                              "v0.0.0-20210106213030-5aafc221ea8c",
+		"github.com/rogpeppe/go-internal":                               "v1.8.1",
+		"github.com/shirou/gopsutil/v3":                                 "v3.23.12",
+		"github.com/shoenig/go-m1cpu":                                   "v0.1.6",
+		"github.com/sirupsen/logrus":                                    "v1.9.3",
+		"github.com/stretchr/testify":                                   "v1.8.4",
+		"github.com/tklauser/go-sysconf":                                "v0.3.12",
+		"github.com/tklauser/numcpus":                                   "v0.6.1",
+		"github.com/yusufpapurcu/wmi":                                   "v1.2.3",
+		"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp": "v0.45.0",
+		"go.opentelemetry.io/otel":                                      "v1.19.0",
+		"go.opentelemetry.io/otel/metric":                               "v1.19.0",
+		"go.opentelemetry.io/otel/trace":                                "v1.19.0",
+		"golang.org/x/exp":                                              "v0.0.0-20230510235704-dd950f8aeaea",
+		"golang.org/x/mod":                                              "v0.11.0",
+		"golang.org/x/sys":                                              "v0.16.0",
+		"golang.org/x/time":                                             "v0.3.0",
+		"golang.org/x/tools":                                            "v0.10.0",
+		"google.golang.org/genproto/googleapis/rpc":                     "v0.0.0-20230711160842-782d3b101e98",
+		"google.golang.org/grpc":                                        "v1.58.3",
+		"google.golang.org/protobuf":                                    "v1.31.0",
+		"gopkg.in/check.v1":                                             "v1.0.0-20201130134442-10cb98267c6c",
+		"gopkg.in/yaml.v3":                                              "v3.0.1",
+		"gotest.tools/v3":                                               "v3.5.0",
+	},
+	"github.com/twmb/franz-go@v1.15.4": {
+		"github.com/klauspost/compress":     "v1.16.7",
+		"github.com/pierrec/lz4/v4":         "v4.1.19",
+		"github.com/twmb/franz-go/pkg/kmsg": "v1.7.0",
+		"golang.org/x/crypto":               "v0.17.0",
+	},
+	"github.com/urfave/cli/v2@v2.27.1": {
+		"github.com/cpuguy83/go-md2man/v2":   "v2.0.2",
+		"github.com/russross/blackfriday/v2": "v2.1.0",
+		"github.com/xrash/smetrics":          "v0.0.0-20201216005158-039620a65673",
+	},
+	"github.com/vektah/gqlparser/v2@v2.5.11": {
+		"github.com/agnivade/levenshtein": "v1.1.1",
+	},
+	"go.mongodb.org/mongo-driver@v1.13.1": {
+		"github.com/davecgh/go-spew":    "v1.1.1",
+		"github.com/golang/snappy":      "v0.0.1",
+		"github.com/google/go-cmp":      "v0.5.2",
+		"github.com/klauspost/compress": "v1.13.6",
+		"github.com/montanaflynn/stats": "v0.0.0-20171201202039-1bf9dbcd8cbe",
+		"github.com/xdg-go/pbkdf2":      "v1.0.0",
+		"github.com/xdg-go/scram":       "v1.1.2",
+		"github.com/xdg-go/stringprep":  "v1.0.4",
+		"github.com/youmark/pkcs8":      "v0.0.0-20181117223130-1be2e3e5546d",
+		"golang.org/x/crypto":           "v0.0.0-20220622213112-05595931fe9d",
+		"golang.org/x/sync":             "v0.0.0-20220722155255-886fb9371eb4",
+		"golang.org/x/text":             "v0.7.0",
+	},
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp@v0.47.0": {
+		"github.com/felixge/httpsnoop":    "v1.0.4",
+		"github.com/go-logr/logr":         "v1.4.1",
+		"github.com/go-logr/stdr":         "v1.2.2",
+		"go.opentelemetry.io/otel":        "v1.22.0",
+		"go.opentelemetry.io/otel/metric": "v1.22.0",
+		"go.opentelemetry.io/otel/trace":  "v1.22.0",
+	},
+	"go.opentelemetry.io/otel@v1.22.0": {
+		"github.com/go-logr/logr":         "v1.4.1",
+		"github.com/go-logr/stdr":         "v1.2.2",
+		"go.opentelemetry.io/otel/metric": "v1.22.0",
+		"go.opentelemetry.io/otel/trace":  "v1.22.0",
+	},
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp@v1.22.0": {
+		"github.com/cenkalti/backoff/v4":                    "v4.2.1",
+		"github.com/go-logr/logr":                           "v1.4.1",
+		"github.com/go-logr/stdr":                           "v1.2.2",
+		"github.com/golang/protobuf":                        "v1.5.3",
+		"github.com/grpc-ecosystem/grpc-gateway/v2":         "v2.16.0",
+		"go.opentelemetry.io/otel":                          "v1.22.0",
+		"go.opentelemetry.io/otel/exporters/otlp/otlptrace": "v1.22.0",
+		"go.opentelemetry.io/otel/metric":                   "v1.22.0",
+		"go.opentelemetry.io/otel/sdk":                      "v1.22.0",
+		"go.opentelemetry.io/otel/trace":                    "v1.22.0",
+		"go.opentelemetry.io/proto/otlp":                    "v1.0.0",
+		"golang.org/x/net":                                  "v0.17.0",
+		"golang.org/x/sys":                                  "v0.16.0",
+		"golang.org/x/text":                                 "v0.13.0",
+		"google.golang.org/genproto/googleapis/api":         "v0.0.0-20231002182017-d307bd883b97",
+		"google.golang.org/genproto/googleapis/rpc":         "v0.0.0-20231002182017-d307bd883b97",
+		"google.golang.org/grpc":                            "v1.60.1",
+		"google.golang.org/protobuf":                        "v1.32.0",
+	},
+	"go.opentelemetry.io/otel/sdk@v1.22.0": {
+		"github.com/go-logr/logr":         "v1.4.1",
+		"github.com/go-logr/stdr":         "v1.2.2",
+		"go.opentelemetry.io/otel":        "v1.22.0",
+		"go.opentelemetry.io/otel/metric": "v1.22.0",
+		"go.opentelemetry.io/otel/trace":  "v1.22.0",
+		"golang.org/x/sys":                "v0.16.0",
+	},
+	"go.uber.org/zap@v1.26.0": {
+		"go.uber.org/multierr": "v1.10.0",
+	},
+	"google.golang.org/grpc@v1.60.1": {
+		"github.com/golang/protobuf":                "v1.5.3",
+		"golang.org/x/net":                          "v0.16.0",
+		"golang.org/x/sys":                          "v0.13.0",
+		"golang.org/x/text":                         "v0.13.0",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20231002182017-d307bd883b97",
+		"google.golang.org/protobuf":                "v1.31.0",
+	},
+	"gorm.io/gorm@v1.25.5": {
+		"github.com/jinzhu/inflection": "v1.0.0",
+		"github.com/jinzhu/now":        "v1.1.5",
+	},
+}
+
+// resolveModules returns the versions go.mod requires for deps: the direct
+// dependencies and the indirect requirements indirectDependencies lists for
+// them. Each module gets the version minimal version selection would pick:
+// the highest any of the required module versions asks for, following
+// moduleRequirements until nothing changes. A direct dependency can end up
+// newer than its pinned version when another one needs it. Modules that are
+// already direct dependencies are left out of indirect.
+func resolveModules(deps map[string]string) (direct, indirect map[string]string) {
+	direct = make(map[string]string, len(deps))
+	for pkg, version := range deps {
+		direct[pkg] = version
+	}
+	indirect = make(map[string]string)
+	raise := func(selected map[string]string, mod, v string) bool {
+		if current, ok := selected[mod]; !ok || compareVersions(v, current) > 0 {
+			selected[mod] = v
+			return true
+		}
+		return false
+	}
+	for pkg, version := range deps {
+		for mod, v := range indirectDependencies[pkg+"@"+version] {
+			if _, ok := direct[mod]; ok {
+				raise(direct, mod, v)
+			} else {
+				raise(indirect, mod, v)
+			}
+		}
+	}
+
+	// Requirements only matter for modules go.mod lists; raising one can
+	// bring in the requirements of its newer version, so repeat until stable
+	for changed := true; changed; {
+		changed = false
+		for _, selected := range []map[string]string{direct, indirect} {
+			for _, pkg := range sortedPackages(selected) {
+				for mod, v := range moduleRequirements[pkg+"@"+selected[pkg]] {
+					if _, ok := direct[mod]; ok {
+						changed = raise(direct, mod, v) || changed
+					} else if _, ok := indirect[mod]; ok {
+						changed = raise(indirect, mod, v) || changed
+					}
+				}
+			}
+		}
+	}
+	return direct, indirect
+}
+
+// compareVersions orders two semantic versions by their major, minor and
+// patch numbers, returning -1, 0 or +1. A release sorts after its
+// pre-releases, and pre-releases of one release are ordered by their text,
+// which sorts pseudo-versions by commit time.
+func compareVersions(a, b string) int {
+	ma, mb := semverPattern.FindStringSubmatch(a), semverPattern.FindStringSubmatch(b)
+	if ma != nil && mb != nil {
+		for i := 1; i <= 3; i++ {
+			x, _ := strconv.Atoi(ma[i])
+			y, _ := strconv.Atoi(mb[i])
+			if x != y {
+				if x < y {
+					return -1
+				}
+				return 1
+			}
+		}
+		switch {
+		case ma[4] == "" && mb[4] != "":
+			return 1
+		case ma[4] != "" && mb[4] == "":
+			return -1
+		}
+	}
+	return strings.Compare(a, b)
+}
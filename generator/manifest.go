@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ManifestFile is written at the project root when IncludeManifest is set
+const ManifestFile = ".go-initializer.json"
+
+// Version identifies the generator build in manifests. Release builds set it
+// with -ldflags "-X github.com/thirukguru/go-initializer/generator.Version=v1.2.3".
+var Version = "dev"
+
+// Manifest records the options a project was generated with, so tools can
+// inspect the project or generate it again with the same choices
+type Manifest struct {
+	GeneratorVersion string    `json:"generator_version"`
+	GeneratedAt      time.Time `json:"generated_at"`
+
+	ProjectName string `json:"project_name"`
+	Module      string `json:"module"`
+	GoVersion   string `json:"go_version"`
+	Structure   string `json:"structure"`
+	ProjectType string `json:"project_type"`
+	Router      string `json:"router,omitempty"`
+	Logger      string `json:"logger,omitempty"`
+	Port        int    `json:"port"`
+	FeatureName string `json:"feature_name,omitempty"`
+
+	// FeatureNames lists every feature of the feature layout; FeatureName is the first
+	FeatureNames []string `json:"feature_names,omitempty"`
+
+	// Services lists the services of a monorepo
+	Services []string `json:"services,omitempty"`
+
+	Database    string `json:"database,omitempty"`
+	License     string `json:"license,omitempty"`
+	CIProvider  string `json:"ci_provider,omitempty"`
+	ConfigStyle string `json:"config_style,omitempty"`
+
+	// PlatformTarget is the PaaS a deploy descriptor was generated for
+	PlatformTarget string `json:"platform_target,omitempty"`
+
+	// DependencyBot is the dependency update bot a configuration was generated for
+	DependencyBot string `json:"dependency_bot,omitempty"`
+
+	// DefaultBranch is the branch the CI pipeline was set up for
+	DefaultBranch string `json:"default_branch,omitempty"`
+
+	// Features lists the enabled options by their API name, e.g. "use_docker"
+	Features []string `json:"features"`
+
+	// Dependencies are the catalog entries that were selected, as given
+	Dependencies []string `json:"dependencies"`
+
+	// Modules are the module versions written to go.mod
+	Modules map[string]string `json:"modules"`
+}
+
+// generateManifest describes config and the resolved deps as indented JSON
+func generateManifest(config ProjectConfig, deps map[string]string) ([]byte, error) {
+	manifest := Manifest{
+		GeneratorVersion: Version,
+		GeneratedAt:      time.Now().UTC().Truncate(time.Second),
+		ProjectName:      config.ProjectName,
+		Module:           config.Module,
+		GoVersion:        config.GoVersion,
+		Structure:        config.Structure,
+		ProjectType:      config.ProjectType,
+		Router:           config.Router,
+		Logger:           config.Logger,
+		Port:             config.ListenPort(),
+		License:          config.License,
+		CIProvider:       config.CIProvider,
+		Features:         []string{},
+		Dependencies:     config.Dependencies,
+		Modules:          deps,
+	}
+	if config.UseDatabase {
+		manifest.Database = config.Database
+	}
+	if config.Structure == "feature" {
+		manifest.FeatureName = config.FeatureName
+		manifest.FeatureNames = config.Features
+	}
+	if config.UseServices() {
+		manifest.Services = config.Services
+	}
+	if config.UseViper() {
+		manifest.ConfigStyle = config.ConfigStyle
+	}
+	if platform := platformTarget(config); platform != "none" {
+		manifest.PlatformTarget = platform
+	}
+	if bot := dependencyBot(config); bot != "none" {
+		manifest.DependencyBot = bot
+	}
+	if ciProvider(config) != "none" {
+		manifest.DefaultBranch = config.DefaultBranch
+	}
+	if manifest.Dependencies == nil {
+		manifest.Dependencies = []string{}
+	}
+
+	features := []struct {
+		name    string
+		enabled bool
+	}{
+		{"use_docker", config.UseDocker},
+		{"use_github", config.UseGitHub},
+		{"use_config", config.UseConfig},
+		{"use_logger", config.UseLogger},
+		{"use_database", config.UseDatabase},
+		{"use_redis", config.UseRedis},
+		{"use_jwt", config.UseJWT},
+		{"use_air", config.UseAir},
+		{"use_linter", config.UseLinter},
+		{"use_kubernetes", config.UseKubernetes},
+		{"use_dev_tooling", config.UseDevTooling},
+		{"use_sbom", config.UseSBOM && ciProvider(config) != "none"},
+		{"use_dotenv", config.UseDotenvLoader()},
+		{"use_sqlc", config.UseSqlc},
+		{"use_swagger", config.UseSwagger},
+		{"use_buf", config.UseBufTooling()},
+	}
+	for _, f := range features {
+		if f.enabled {
+			manifest.Features = append(manifest.Features, f.name)
+		}
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
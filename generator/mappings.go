@@ -7,41 +7,270 @@ type FileMapping struct {
 	TemplatePath string
 	OutputPath   string
 	Condition    func(config ProjectConfig) bool // Optional: only include if condition is true
+
+	// PerFeature renders the mapping once for each of ProjectConfig.Features,
+	// with FeatureName set to that feature
+	PerFeature bool
+
+	// PerService renders the mapping once for each of
+	// ProjectConfig.ServiceNames, with ServiceName set to that service
+	PerService bool
 }
 
-/*type ProjectConfig struct {
-	Structure    string // "standard", "flat", "feature", "hexagonal"
-	ProjectType  string // "rest-api", "cli", "grpc", "library"
-	ProjectName  string
-	Module       string
-	Description  string
-	GoVersion    string
-	Router       string // "chi", "gin", "echo", "fiber", "stdlib"
-	Logger       string // "zerolog", "zap", "slog", "logrus", "stdlib"
-	UseDocker    bool
-	UseGitHub    bool
-	UseConfig    bool
-	UseLogger    bool
-	UseDatabase  bool
-	UseRedis     bool
-	UseJWT       bool
-	UseAir       bool
-}*/
+// mappingConfigs returns the configs mapping is rendered with: config itself,
+// or one copy per feature or service when the mapping is rendered per feature
+// or service. A config without Features has the single feature FeatureName.
+func mappingConfigs(mapping FileMapping, config ProjectConfig) []ProjectConfig {
+	if mapping.PerService {
+		services := config.ServiceNames()
+		configs := make([]ProjectConfig, len(services))
+		for i, name := range services {
+			configs[i] = config
+			configs[i].ServiceName = name
+		}
+		return configs
+	}
+	if !mapping.PerFeature || len(config.Features) == 0 {
+		return []ProjectConfig{config}
+	}
+	configs := make([]ProjectConfig, len(config.Features))
+	for i, name := range config.Features {
+		configs[i] = config
+		configs[i].FeatureName = name
+	}
+	return configs
+}
 
-// GetFileMappings returns the file mappings for a given project structure
+// GetFileMappings returns the file mappings for a given project structure.
+// Empty means "standard"; an unknown structure has no mappings.
 func GetFileMappings(structure string) []FileMapping {
+	var mappings []FileMapping
 	switch structure {
-	case "standard":
-		return standardLayoutMappings()
+	case "", "standard":
+		mappings = standardLayoutMappings()
 	case "flat":
-		return flatLayoutMappings()
+		mappings = flatLayoutMappings()
 	case "feature":
-		return featureLayoutMappings()
+		mappings = featureLayoutMappings()
 	case "hexagonal":
-		return hexagonalLayoutMappings()
+		mappings = hexagonalLayoutMappings()
+	case "clean":
+		mappings = cleanLayoutMappings()
 	default:
-		return standardLayoutMappings()
+		return nil
+	}
+	return append(mappings, commonMappings()...)
+}
+
+// commonMappings returns the files generated regardless of project structure
+func commonMappings() []FileMapping {
+	return []FileMapping{
+		// Ownership
+		{
+			TemplatePath: "standard/CODEOWNERS.tmpl",
+			OutputPath:   "CODEOWNERS",
+			Condition:    func(c ProjectConfig) bool { return c.AuthorEmail != "" },
+		},
+		// Docker build context
+		{
+			TemplatePath: "standard/dockerignore.tmpl",
+			OutputPath:   ".dockerignore",
+			Condition:    useDocker,
+		},
+		// Live reload
+		{
+			TemplatePath: "standard/air.toml.tmpl",
+			OutputPath:   ".air.toml",
+			Condition:    func(c ProjectConfig) bool { return c.UseAir && hasMain(c) },
+		},
+		// Editor settings and pre-commit hooks
+		{
+			TemplatePath: "standard/editorconfig.tmpl",
+			OutputPath:   ".editorconfig",
+			Condition:    func(c ProjectConfig) bool { return c.UseDevTooling },
+		},
+		{
+			TemplatePath: "standard/pre-commit-config.yaml.tmpl",
+			OutputPath:   ".pre-commit-config.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseDevTooling },
+		},
+		// Library package at the module root (flat projects keep package main there)
+		{
+			TemplatePath: "standard/library.go.tmpl",
+			OutputPath:   "{{.PackageName}}.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "library" },
+		},
+		{
+			TemplatePath: "standard/example_test.go.tmpl",
+			OutputPath:   "example_test.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "library" },
+		},
+		// PaaS deploy descriptors
+		{
+			TemplatePath: "platform/Procfile.tmpl",
+			OutputPath:   "Procfile",
+			Condition:    func(c ProjectConfig) bool { return platformTarget(c) == "heroku" },
+		},
+		{
+			TemplatePath: "platform/railway.toml.tmpl",
+			OutputPath:   "railway.toml",
+			Condition:    func(c ProjectConfig) bool { return platformTarget(c) == "railway" },
+		},
+		// Dependency update bots
+		{
+			TemplatePath: "standard/dependabot.yml.tmpl",
+			OutputPath:   ".github/dependabot.yml",
+			Condition:    func(c ProjectConfig) bool { return dependencyBot(c) == "dependabot" },
+		},
+		{
+			TemplatePath: "standard/renovate.json.tmpl",
+			OutputPath:   "renovate.json",
+			Condition:    func(c ProjectConfig) bool { return dependencyBot(c) == "renovate" },
+		},
+		// Default settings read by the Viper config package
+		{
+			TemplatePath: "standard/config.yaml.tmpl",
+			OutputPath:   "config.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseViper() },
+		},
+		// Message broker clients, one file per selected broker
+		{
+			TemplatePath: "messaging/config.go.tmpl",
+			OutputPath:   "internal/messaging/config.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseMessaging() },
+		},
+		{
+			TemplatePath: "messaging/kafka.go.tmpl",
+			OutputPath:   "internal/messaging/kafka.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseKafka() },
+		},
+		{
+			TemplatePath: "messaging/rabbitmq.go.tmpl",
+			OutputPath:   "internal/messaging/rabbitmq.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseRabbitMQ() },
+		},
+		{
+			TemplatePath: "messaging/nats.go.tmpl",
+			OutputPath:   "internal/messaging/nats.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseNATS() },
+		},
+		// Database migrations (SQL databases only)
+		{
+			TemplatePath: "database/0001_init.up.sql.tmpl",
+			OutputPath:   "migrations/0001_init.up.sql",
+			Condition:    func(c ProjectConfig) bool { return c.UseSQLDatabase() },
+		},
+		{
+			TemplatePath: "database/0001_init.down.sql.tmpl",
+			OutputPath:   "migrations/0001_init.down.sql",
+			Condition:    func(c ProjectConfig) bool { return c.UseSQLDatabase() },
+		},
+		// sqlc (Postgres only); the schema matches the initial migration
+		{
+			TemplatePath: "sqlc/sqlc.yaml.tmpl",
+			OutputPath:   "sqlc.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseSqlcQueries() },
+		},
+		{
+			TemplatePath: "database/0001_init.up.sql.tmpl",
+			OutputPath:   "db/schema.sql",
+			Condition:    func(c ProjectConfig) bool { return c.UseSqlcQueries() },
+		},
+		{
+			TemplatePath: "sqlc/users.sql.tmpl",
+			OutputPath:   "db/queries/users.sql",
+			Condition:    func(c ProjectConfig) bool { return c.UseSqlcQueries() },
+		},
+		// License
+		{
+			TemplatePath: "license/mit.tmpl",
+			OutputPath:   "LICENSE",
+			Condition:    func(c ProjectConfig) bool { return c.License == "mit" },
+		},
+		{
+			TemplatePath: "license/apache-2.0.tmpl",
+			OutputPath:   "LICENSE",
+			Condition:    func(c ProjectConfig) bool { return c.License == "apache-2.0" },
+		},
+		{
+			TemplatePath: "license/gpl-3.0.tmpl",
+			OutputPath:   "LICENSE",
+			Condition:    func(c ProjectConfig) bool { return c.License == "gpl-3.0" },
+		},
+		{
+			TemplatePath: "license/bsd-3-clause.tmpl",
+			OutputPath:   "LICENSE",
+			Condition:    func(c ProjectConfig) bool { return c.License == "bsd-3-clause" },
+		},
+	}
+}
+
+// useStdlibREST reports whether a REST API should be served by net/http alone
+func useStdlibREST(c ProjectConfig) bool {
+	return c.ProjectType == "rest-api" && c.Router == "stdlib"
+}
+
+// useTracing reports whether the tracing package is generated: OpenTelemetry
+// was selected and the layout has an HTTP entrypoint to instrument
+func useTracing(c ProjectConfig) bool {
+	if !c.UseOpenTelemetry() || c.Structure == "flat" {
+		return false
 	}
+	return c.ProjectType == "rest-api"
+}
+
+// ciProvider returns the CI system to generate a pipeline for. UseGitHub is
+// kept as an alias for "github" when no provider is set.
+func ciProvider(c ProjectConfig) string {
+	if c.CIProvider != "" {
+		return c.CIProvider
+	}
+	if c.UseGitHub {
+		return "github"
+	}
+	return "none"
+}
+
+// useKubernetes reports whether the standard layout gets Kubernetes
+// manifests: they deploy the one image the Dockerfile builds, so a monorepo
+// of several services gets none
+func useKubernetes(c ProjectConfig) bool {
+	return c.UseKubernetes && useDocker(c) && !c.UseServices()
+}
+
+// hasMain reports whether the project has a main package to build and run:
+// every project type but a library, which is a package at the module root
+func hasMain(c ProjectConfig) bool {
+	return c.ProjectType != "library"
+}
+
+// useDocker reports whether a Dockerfile and compose file are generated:
+// Docker was requested for a project with a binary to put in the image
+func useDocker(c ProjectConfig) bool {
+	return c.UseDocker && hasMain(c)
+}
+
+// dependencyBot returns the dependency update bot to configure. Dependabot
+// is part of GitHub, so it is only configured along with GitHub CI.
+func dependencyBot(c ProjectConfig) string {
+	switch c.UseDependencyBot {
+	case "dependabot":
+		if ciProvider(c) == "github" {
+			return "dependabot"
+		}
+	case "renovate":
+		return "renovate"
+	}
+	return "none"
+}
+
+// platformTarget returns the PaaS to generate a deploy descriptor for. Only
+// REST and GraphQL services get one, since both platforms route plain HTTP.
+func platformTarget(c ProjectConfig) string {
+	if c.PlatformTarget == "" || (c.ProjectType != "rest-api" && c.ProjectType != "graphql") {
+		return "none"
+	}
+	return c.PlatformTarget
 }
 
 func standardLayoutMappings() []FileMapping {
@@ -49,23 +278,238 @@ func standardLayoutMappings() []FileMapping {
 		// Main application
 		{
 			TemplatePath: "standard/cmd_main.go.tmpl",
-			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			OutputPath:   "cmd/{{.ServiceName}}/main.go",
+			Condition: func(c ProjectConfig) bool {
+				return !useStdlibREST(c) && hasMain(c) && c.ProjectType != "cli" && c.ProjectType != "grpc" && c.ProjectType != "graphql"
+			},
+			PerService: true,
+		},
+		{
+			TemplatePath: "standard/cmd_main_stdlib.go.tmpl",
+			OutputPath:   "cmd/{{.ServiceName}}/main.go",
+			Condition:    useStdlibREST,
+			PerService:   true,
 		},
 		// Internal packages
 		{
 			TemplatePath: "standard/internal_handler.go.tmpl",
 			OutputPath:   "internal/handler/handler.go",
-			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" },
+			// net/http handlers, shared by chi and the plain mux used without a router
+			Condition: func(c ProjectConfig) bool {
+				return c.ProjectType == "rest-api" && (c.Router == "chi" || c.Router == "")
+			},
+		},
+		{
+			TemplatePath: "standard/internal_handler_gin.go.tmpl",
+			OutputPath:   "internal/handler/handler.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.Router == "gin" },
+		},
+		{
+			TemplatePath: "standard/internal_handler_echo.go.tmpl",
+			OutputPath:   "internal/handler/handler.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.Router == "echo" },
+		},
+		{
+			TemplatePath: "standard/internal_handler_fiber.go.tmpl",
+			OutputPath:   "internal/handler/handler.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.Router == "fiber" },
+		},
+		{
+			TemplatePath: "standard/internal_handler_stdlib.go.tmpl",
+			OutputPath:   "internal/handler/handler.go",
+			Condition:    useStdlibREST,
 		},
 		{
 			TemplatePath: "standard/internal_config.go.tmpl",
 			OutputPath:   "internal/config/config.go",
-			Condition:    func(c ProjectConfig) bool { return c.UseConfig },
+			Condition:    func(c ProjectConfig) bool { return c.UseConfig && !c.UseViper() },
+		},
+		{
+			TemplatePath: "standard/internal_config_viper.go.tmpl",
+			OutputPath:   "internal/config/config.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseViper() },
+		},
+		// API documentation
+		{
+			TemplatePath: "openapi/openapi.yaml.tmpl",
+			OutputPath:   "docs/openapi.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseSwaggerDocs() },
+		},
+		{
+			TemplatePath: "openapi/docs.go.tmpl",
+			OutputPath:   "docs/docs.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseSwaggerDocs() },
+		},
+		// Handlers scaffolded from an uploaded OpenAPI document
+		{
+			TemplatePath: "openapi/types.go.tmpl",
+			OutputPath:   "internal/openapi/types.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseOpenAPIHandlers() },
+		},
+		{
+			TemplatePath: "openapi/handlers.go.tmpl",
+			OutputPath:   "internal/openapi/handlers.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseOpenAPIHandlers() },
+		},
+		{
+			TemplatePath: "database/db.go.tmpl",
+			OutputPath:   "internal/database/db.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseDatabase && c.UseConfig },
+		},
+		{
+			TemplatePath: "database/db_test.go.tmpl",
+			OutputPath:   "internal/database/db_test.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseIntegrationTests() },
+		},
+		{
+			TemplatePath: "health/health.go.tmpl",
+			OutputPath:   "internal/health/health.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" || c.ProjectType == "graphql" },
+		},
+		{
+			TemplatePath: "metrics/metrics.go.tmpl",
+			OutputPath:   "internal/metrics/metrics.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.UsePrometheus() },
+		},
+		{
+			TemplatePath: "tracing/tracing.go.tmpl",
+			OutputPath:   "internal/tracing/tracing.go",
+			Condition:    useTracing,
+		},
+		{
+			TemplatePath: "cache/redis.go.tmpl",
+			OutputPath:   "internal/cache/redis.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseRedis },
+		},
+		{
+			TemplatePath: "standard/internal_middleware_auth.go.tmpl",
+			OutputPath:   "internal/middleware/auth.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.UseJWT },
+		},
+		{
+			TemplatePath: "standard/internal_handler_auth.go.tmpl",
+			OutputPath:   "internal/handler/auth.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.UseJWT },
+		},
+		// WebSocket echo example (Gorilla WebSocket selected)
+		{
+			TemplatePath: "websocket/ws.go.tmpl",
+			OutputPath:   "internal/handler/ws.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseWebSocket() },
+		},
+		// templ view example (Templ selected); the _templ.go files come from `make templ`
+		{
+			TemplatePath: "templ/page.go.tmpl",
+			OutputPath:   "internal/handler/page.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseTempl() },
+		},
+		{
+			TemplatePath: "templ/view.go.tmpl",
+			OutputPath:   "view/view.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseTempl() },
+		},
+		{
+			TemplatePath: "templ/hello.templ.tmpl",
+			OutputPath:   "view/hello.templ",
+			Condition:    func(c ProjectConfig) bool { return c.UseTempl() },
+		},
+		{
+			TemplatePath: "templ/tools.go.tmpl",
+			OutputPath:   "tools.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseTempl() },
 		},
 		{
 			TemplatePath: "standard/internal_middleware.go.tmpl",
 			OutputPath:   "internal/middleware/logger.go",
-			Condition:    func(c ProjectConfig) bool { return c.UseLogger && c.Router == "chi" },
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.UseLogger && c.Router == "chi" },
+		},
+		{
+			TemplatePath: "standard/internal_middleware_gin.go.tmpl",
+			OutputPath:   "internal/middleware/logger.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.UseLogger && c.Router == "gin" },
+		},
+		{
+			TemplatePath: "standard/internal_middleware_echo.go.tmpl",
+			OutputPath:   "internal/middleware/logger.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.UseLogger && c.Router == "echo" },
+		},
+		{
+			TemplatePath: "standard/internal_middleware_fiber.go.tmpl",
+			OutputPath:   "internal/middleware/logger.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.UseLogger && c.Router == "fiber" },
+		},
+		// CLI commands
+		{
+			TemplatePath: "cli/main.go.tmpl",
+			OutputPath:   "main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "cli" },
+		},
+		{
+			TemplatePath: "cli/cmd_root.go.tmpl",
+			OutputPath:   "cmd/root.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "cli" },
+		},
+		{
+			TemplatePath: "cli/cmd_version.go.tmpl",
+			OutputPath:   "cmd/version.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "cli" },
+		},
+		// gRPC service
+		{
+			TemplatePath: "grpc/cmd_main.go.tmpl",
+			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "grpc" },
+		},
+		{
+			TemplatePath: "grpc/service.proto.tmpl",
+			OutputPath:   "proto/service.proto",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "grpc" },
+		},
+		{
+			TemplatePath: "grpc/buf.yaml.tmpl",
+			OutputPath:   "buf.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseBufTooling() },
+		},
+		{
+			TemplatePath: "grpc/buf.gen.yaml.tmpl",
+			OutputPath:   "buf.gen.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseBufTooling() },
+		},
+		{
+			TemplatePath: "grpc/internal_server.go.tmpl",
+			OutputPath:   "internal/server/grpc.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "grpc" },
+		},
+		// GraphQL server (gqlgen); graph/generated.go and graph/model come from `make generate`
+		{
+			TemplatePath: "graphql/cmd_main.go.tmpl",
+			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "graphql" },
+		},
+		{
+			TemplatePath: "graphql/gqlgen.yml.tmpl",
+			OutputPath:   "gqlgen.yml",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "graphql" },
+		},
+		{
+			TemplatePath: "graphql/schema.graphqls.tmpl",
+			OutputPath:   "graph/schema.graphqls",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "graphql" },
+		},
+		{
+			TemplatePath: "graphql/resolver.go.tmpl",
+			OutputPath:   "graph/resolver.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "graphql" },
+		},
+		{
+			TemplatePath: "graphql/schema.resolvers.go.tmpl",
+			OutputPath:   "graph/schema.resolvers.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "graphql" },
+		},
+		{
+			TemplatePath: "graphql/tools.go.tmpl",
+			OutputPath:   "tools.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "graphql" },
 		},
 		// Pkg (shared libraries)
 		{
@@ -77,6 +521,12 @@ func standardLayoutMappings() []FileMapping {
 		{
 			TemplatePath: "standard/README.md.tmpl",
 			OutputPath:   "README.md",
+			Condition:    hasMain,
+		},
+		{
+			TemplatePath: "standard/README_library.md.tmpl",
+			OutputPath:   "README.md",
+			Condition:    func(c ProjectConfig) bool { return !hasMain(c) },
 		},
 		{
 			TemplatePath: "standard/Makefile.tmpl",
@@ -86,26 +536,57 @@ func standardLayoutMappings() []FileMapping {
 			TemplatePath: "standard/gitignore.tmpl",
 			OutputPath:   ".gitignore",
 		},
+		{
+			TemplatePath: "standard/golangci.yml.tmpl",
+			OutputPath:   ".golangci.yml",
+			Condition:    func(c ProjectConfig) bool { return c.UseLinter },
+		},
 		{
 			TemplatePath: "standard/env.example.tmpl",
 			OutputPath:   ".env.example",
+			Condition:    hasMain,
 		},
 		// Docker
 		{
 			TemplatePath: "standard/Dockerfile.tmpl",
 			OutputPath:   "Dockerfile",
-			Condition:    func(c ProjectConfig) bool { return c.UseDocker },
+			Condition:    useDocker,
+		},
+		{
+			TemplatePath: "k8s/deployment.yaml.tmpl",
+			OutputPath:   "deploy/k8s/deployment.yaml",
+			Condition:    func(c ProjectConfig) bool { return useKubernetes(c) },
+		},
+		{
+			TemplatePath: "k8s/service.yaml.tmpl",
+			OutputPath:   "deploy/k8s/service.yaml",
+			Condition:    func(c ProjectConfig) bool { return useKubernetes(c) },
+		},
+		{
+			TemplatePath: "k8s/configmap.yaml.tmpl",
+			OutputPath:   "deploy/k8s/configmap.yaml",
+			Condition:    func(c ProjectConfig) bool { return useKubernetes(c) },
 		},
 		{
 			TemplatePath: "standard/docker-compose.yaml.tmpl",
 			OutputPath:   "docker-compose.yaml",
-			Condition:    func(c ProjectConfig) bool { return c.UseDocker },
+			Condition:    useDocker,
 		},
 		// CI/CD
 		{
 			TemplatePath: "standard/github_ci.yaml.tmpl",
 			OutputPath:   ".github/workflows/ci.yml",
-			Condition:    func(c ProjectConfig) bool { return c.UseGitHub },
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "github" },
+		},
+		{
+			TemplatePath: "standard/gitlab_ci.yml.tmpl",
+			OutputPath:   ".gitlab-ci.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "gitlab" },
+		},
+		{
+			TemplatePath: "standard/circleci_config.yml.tmpl",
+			OutputPath:   ".circleci/config.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "circleci" },
 		},
 	}
 }
@@ -120,15 +601,55 @@ func flatLayoutMappings() []FileMapping {
 			TemplatePath: "flat/README.md.tmpl",
 			OutputPath:   "README.md",
 		},
+		{
+			TemplatePath: "standard/Makefile.tmpl",
+			OutputPath:   "Makefile",
+		},
 		{
 			TemplatePath: "standard/gitignore.tmpl",
 			OutputPath:   ".gitignore",
 		},
+		{
+			TemplatePath: "standard/golangci.yml.tmpl",
+			OutputPath:   ".golangci.yml",
+			Condition:    func(c ProjectConfig) bool { return c.UseLinter },
+		},
 		{
 			TemplatePath: "standard/Dockerfile.tmpl",
 			OutputPath:   "Dockerfile",
 			Condition:    func(c ProjectConfig) bool { return c.UseDocker },
 		},
+		{
+			TemplatePath: "k8s/deployment.yaml.tmpl",
+			OutputPath:   "deploy/k8s/deployment.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		{
+			TemplatePath: "k8s/service.yaml.tmpl",
+			OutputPath:   "deploy/k8s/service.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		{
+			TemplatePath: "k8s/configmap.yaml.tmpl",
+			OutputPath:   "deploy/k8s/configmap.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		// CI/CD
+		{
+			TemplatePath: "standard/github_ci.yaml.tmpl",
+			OutputPath:   ".github/workflows/ci.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "github" },
+		},
+		{
+			TemplatePath: "standard/gitlab_ci.yml.tmpl",
+			OutputPath:   ".gitlab-ci.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "gitlab" },
+		},
+		{
+			TemplatePath: "standard/circleci_config.yml.tmpl",
+			OutputPath:   ".circleci/config.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "circleci" },
+		},
 	}
 }
 
@@ -138,28 +659,123 @@ func featureLayoutMappings() []FileMapping {
 		{
 			TemplatePath: "feature/cmd_main.go.tmpl",
 			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType != "grpc" && c.ProjectType != "cli" },
+		},
+		{
+			TemplatePath: "feature/cmd_main_cli.go.tmpl",
+			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "cli" },
+		},
+		{
+			TemplatePath: "feature/cmd_main_grpc.go.tmpl",
+			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "grpc" },
 		},
-		// User feature
+		// Sample feature (FeatureName); gRPC services get a server and proto
+		// instead of HTTP handlers, and CLIs neither
 		{
 			TemplatePath: "feature/user_handler.go.tmpl",
-			OutputPath:   "internal/user/handler.go",
+			OutputPath:   "internal/{{.FeatureName}}/handler.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType != "grpc" && c.ProjectType != "cli" },
+			PerFeature:   true,
+		},
+		{
+			TemplatePath: "feature/user_grpc.go.tmpl",
+			OutputPath:   "internal/{{.FeatureName}}/grpc.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "grpc" },
+			PerFeature:   true,
+		},
+		{
+			TemplatePath: "feature/user.proto.tmpl",
+			OutputPath:   "proto/{{.FeatureName}}.proto",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "grpc" },
+			PerFeature:   true,
+		},
+		{
+			TemplatePath: "grpc/buf.yaml.tmpl",
+			OutputPath:   "buf.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseBufTooling() },
+		},
+		{
+			TemplatePath: "grpc/buf.gen.yaml.tmpl",
+			OutputPath:   "buf.gen.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseBufTooling() },
 		},
 		{
 			TemplatePath: "feature/user_service.go.tmpl",
-			OutputPath:   "internal/user/service.go",
+			OutputPath:   "internal/{{.FeatureName}}/service.go",
+			PerFeature:   true,
+		},
+		{
+			TemplatePath: "feature/user_service_test.go.tmpl",
+			OutputPath:   "internal/{{.FeatureName}}/service_test.go",
+			Condition:    func(c ProjectConfig) bool { return c.HasDependency(testifyPackage) },
+			PerFeature:   true,
 		},
 		{
 			TemplatePath: "feature/user_repository.go.tmpl",
-			OutputPath:   "internal/user/repository.go",
+			OutputPath:   "internal/{{.FeatureName}}/repository.go",
+			Condition:    func(c ProjectConfig) bool { return !c.UseMongo() },
+			PerFeature:   true,
+		},
+		{
+			TemplatePath: "feature/user_repository_mongo.go.tmpl",
+			OutputPath:   "internal/{{.FeatureName}}/repository.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseMongo() },
+			PerFeature:   true,
 		},
 		{
 			TemplatePath: "feature/user_model.go.tmpl",
-			OutputPath:   "internal/user/model.go",
+			OutputPath:   "internal/{{.FeatureName}}/model.go",
+			PerFeature:   true,
 		},
 		// Config
 		{
 			TemplatePath: "standard/internal_config.go.tmpl",
 			OutputPath:   "pkg/config/config.go",
+			Condition:    func(c ProjectConfig) bool { return !c.UseViper() },
+		},
+		{
+			TemplatePath: "standard/internal_config_viper.go.tmpl",
+			OutputPath:   "pkg/config/config.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseViper() },
+		},
+		{
+			TemplatePath: "database/db.go.tmpl",
+			OutputPath:   "pkg/database/db.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseSQLDatabase() },
+		},
+		{
+			TemplatePath: "database/db_test.go.tmpl",
+			OutputPath:   "pkg/database/db_test.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseIntegrationTests() },
+		},
+		{
+			TemplatePath: "database/mongo.go.tmpl",
+			OutputPath:   "internal/database/mongo.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseMongo() },
+		},
+		{
+			TemplatePath: "health/health.go.tmpl",
+			OutputPath:   "pkg/health/health.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType != "grpc" && c.ProjectType != "cli" },
+		},
+		{
+			TemplatePath: "metrics/metrics.go.tmpl",
+			OutputPath:   "pkg/metrics/metrics.go",
+			Condition: func(c ProjectConfig) bool {
+				return c.ProjectType != "grpc" && c.ProjectType != "cli" && c.UsePrometheus()
+			},
+		},
+		{
+			TemplatePath: "tracing/tracing.go.tmpl",
+			OutputPath:   "pkg/tracing/tracing.go",
+			Condition:    useTracing,
+		},
+		{
+			TemplatePath: "cache/redis.go.tmpl",
+			OutputPath:   "pkg/cache/redis.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseRedis },
 		},
 		// Logger
 		{
@@ -180,6 +796,11 @@ func featureLayoutMappings() []FileMapping {
 			TemplatePath: "standard/gitignore.tmpl",
 			OutputPath:   ".gitignore",
 		},
+		{
+			TemplatePath: "standard/golangci.yml.tmpl",
+			OutputPath:   ".golangci.yml",
+			Condition:    func(c ProjectConfig) bool { return c.UseLinter },
+		},
 		{
 			TemplatePath: "standard/env.example.tmpl",
 			OutputPath:   ".env.example",
@@ -189,11 +810,42 @@ func featureLayoutMappings() []FileMapping {
 			OutputPath:   "Dockerfile",
 			Condition:    func(c ProjectConfig) bool { return c.UseDocker },
 		},
+		{
+			TemplatePath: "k8s/deployment.yaml.tmpl",
+			OutputPath:   "deploy/k8s/deployment.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		{
+			TemplatePath: "k8s/service.yaml.tmpl",
+			OutputPath:   "deploy/k8s/service.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		{
+			TemplatePath: "k8s/configmap.yaml.tmpl",
+			OutputPath:   "deploy/k8s/configmap.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
 		{
 			TemplatePath: "standard/docker-compose.yaml.tmpl",
 			OutputPath:   "docker-compose.yaml",
 			Condition:    func(c ProjectConfig) bool { return c.UseDocker },
 		},
+		// CI/CD
+		{
+			TemplatePath: "standard/github_ci.yaml.tmpl",
+			OutputPath:   ".github/workflows/ci.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "github" },
+		},
+		{
+			TemplatePath: "standard/gitlab_ci.yml.tmpl",
+			OutputPath:   ".gitlab-ci.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "gitlab" },
+		},
+		{
+			TemplatePath: "standard/circleci_config.yml.tmpl",
+			OutputPath:   ".circleci/config.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "circleci" },
+		},
 	}
 }
 
@@ -203,6 +855,12 @@ func hexagonalLayoutMappings() []FileMapping {
 		{
 			TemplatePath: "hexagonal/cmd_main.go.tmpl",
 			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType != "cli" },
+		},
+		{
+			TemplatePath: "hexagonal/cmd_main_cli.go.tmpl",
+			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "cli" },
 		},
 		// Core - Domain
 		{
@@ -219,16 +877,48 @@ func hexagonalLayoutMappings() []FileMapping {
 			TemplatePath: "hexagonal/service_user.go.tmpl",
 			OutputPath:   "internal/core/service/user.go",
 		},
+		{
+			TemplatePath: "hexagonal/service_user_test.go.tmpl",
+			OutputPath:   "internal/core/service/user_test.go",
+			Condition:    func(c ProjectConfig) bool { return c.HasDependency(testifyPackage) },
+		},
 		// Adapters - HTTP Handler
 		{
 			TemplatePath: "hexagonal/adapter_http_handler.go.tmpl",
 			OutputPath:   "internal/adapters/http/handler/user.go",
 			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" },
 		},
+		{
+			TemplatePath: "health/health.go.tmpl",
+			OutputPath:   "internal/adapters/http/health/health.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" },
+		},
+		{
+			TemplatePath: "metrics/metrics.go.tmpl",
+			OutputPath:   "internal/adapters/http/metrics/metrics.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.UsePrometheus() },
+		},
+		{
+			TemplatePath: "tracing/tracing.go.tmpl",
+			OutputPath:   "internal/infrastructure/tracing/tracing.go",
+			Condition:    useTracing,
+		},
 		// Adapters - Repository
 		{
 			TemplatePath: "hexagonal/adapter_repository.go.tmpl",
 			OutputPath:   "internal/adapters/repository/user.go",
+			Condition:    func(c ProjectConfig) bool { return !c.UseMongo() },
+		},
+		{
+			TemplatePath: "hexagonal/adapter_repository_mongo.go.tmpl",
+			OutputPath:   "internal/adapters/repository/user.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseMongo() },
+		},
+		// Infrastructure - Database
+		{
+			TemplatePath: "database/mongo.go.tmpl",
+			OutputPath:   "internal/database/mongo.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseMongo() },
 		},
 		// Infrastructure - Config
 		{
@@ -241,6 +931,12 @@ func hexagonalLayoutMappings() []FileMapping {
 			OutputPath:   "internal/infrastructure/logger/logger.go",
 			Condition:    func(c ProjectConfig) bool { return c.UseLogger },
 		},
+		// Infrastructure - Cache
+		{
+			TemplatePath: "cache/redis.go.tmpl",
+			OutputPath:   "internal/infrastructure/cache/redis.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseRedis },
+		},
 		// Documentation
 		{
 			TemplatePath: "hexagonal/README.md.tmpl",
@@ -255,6 +951,11 @@ func hexagonalLayoutMappings() []FileMapping {
 			TemplatePath: "standard/gitignore.tmpl",
 			OutputPath:   ".gitignore",
 		},
+		{
+			TemplatePath: "standard/golangci.yml.tmpl",
+			OutputPath:   ".golangci.yml",
+			Condition:    func(c ProjectConfig) bool { return c.UseLinter },
+		},
 		{
 			TemplatePath: "standard/env.example.tmpl",
 			OutputPath:   ".env.example",
@@ -264,6 +965,21 @@ func hexagonalLayoutMappings() []FileMapping {
 			OutputPath:   "Dockerfile",
 			Condition:    func(c ProjectConfig) bool { return c.UseDocker },
 		},
+		{
+			TemplatePath: "k8s/deployment.yaml.tmpl",
+			OutputPath:   "deploy/k8s/deployment.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		{
+			TemplatePath: "k8s/service.yaml.tmpl",
+			OutputPath:   "deploy/k8s/service.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		{
+			TemplatePath: "k8s/configmap.yaml.tmpl",
+			OutputPath:   "deploy/k8s/configmap.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
 		{
 			TemplatePath: "standard/docker-compose.yaml.tmpl",
 			OutputPath:   "docker-compose.yaml",
@@ -272,7 +988,146 @@ func hexagonalLayoutMappings() []FileMapping {
 		{
 			TemplatePath: "standard/github_ci.yaml.tmpl",
 			OutputPath:   ".github/workflows/ci.yml",
-			Condition:    func(c ProjectConfig) bool { return c.UseGitHub },
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "github" },
+		},
+		{
+			TemplatePath: "standard/gitlab_ci.yml.tmpl",
+			OutputPath:   ".gitlab-ci.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "gitlab" },
+		},
+		{
+			TemplatePath: "standard/circleci_config.yml.tmpl",
+			OutputPath:   ".circleci/config.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "circleci" },
+		},
+	}
+}
+
+func cleanLayoutMappings() []FileMapping {
+	return []FileMapping{
+		// Main application (composition root)
+		{
+			TemplatePath: "clean/cmd_main.go.tmpl",
+			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType != "cli" },
+		},
+		{
+			TemplatePath: "clean/cmd_main_cli.go.tmpl",
+			OutputPath:   "cmd/{{.ProjectName}}/main.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "cli" },
+		},
+		// Entities
+		{
+			TemplatePath: "clean/entities_user.go.tmpl",
+			OutputPath:   "internal/entities/user.go",
+		},
+		// Use cases
+		{
+			TemplatePath: "clean/usecases_user.go.tmpl",
+			OutputPath:   "internal/usecases/user.go",
+		},
+		// Interface adapters
+		{
+			TemplatePath: "clean/interfaces_controller.go.tmpl",
+			OutputPath:   "internal/interfaces/controller/user.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" },
+		},
+		{
+			TemplatePath: "clean/interfaces_repository.go.tmpl",
+			OutputPath:   "internal/interfaces/repository/user.go",
+		},
+		// Frameworks & drivers
+		{
+			TemplatePath: "health/health.go.tmpl",
+			OutputPath:   "internal/frameworks/web/health/health.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" },
+		},
+		{
+			TemplatePath: "metrics/metrics.go.tmpl",
+			OutputPath:   "internal/frameworks/web/metrics/metrics.go",
+			Condition:    func(c ProjectConfig) bool { return c.ProjectType == "rest-api" && c.UsePrometheus() },
+		},
+		{
+			TemplatePath: "tracing/tracing.go.tmpl",
+			OutputPath:   "internal/frameworks/tracing/tracing.go",
+			Condition:    useTracing,
+		},
+		{
+			TemplatePath: "hexagonal/infra_config.go.tmpl",
+			OutputPath:   "internal/frameworks/config/config.go",
+		},
+		{
+			TemplatePath: "hexagonal/infra_logger.go.tmpl",
+			OutputPath:   "internal/frameworks/logger/logger.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseLogger },
+		},
+		{
+			TemplatePath: "cache/redis.go.tmpl",
+			OutputPath:   "internal/frameworks/cache/redis.go",
+			Condition:    func(c ProjectConfig) bool { return c.UseRedis },
+		},
+		// Documentation
+		{
+			TemplatePath: "clean/README.md.tmpl",
+			OutputPath:   "README.md",
+		},
+		// Configuration files (reuse from standard)
+		{
+			TemplatePath: "standard/Makefile.tmpl",
+			OutputPath:   "Makefile",
+		},
+		{
+			TemplatePath: "standard/gitignore.tmpl",
+			OutputPath:   ".gitignore",
+		},
+		{
+			TemplatePath: "standard/golangci.yml.tmpl",
+			OutputPath:   ".golangci.yml",
+			Condition:    func(c ProjectConfig) bool { return c.UseLinter },
+		},
+		{
+			TemplatePath: "standard/env.example.tmpl",
+			OutputPath:   ".env.example",
+		},
+		{
+			TemplatePath: "standard/Dockerfile.tmpl",
+			OutputPath:   "Dockerfile",
+			Condition:    func(c ProjectConfig) bool { return c.UseDocker },
+		},
+		{
+			TemplatePath: "k8s/deployment.yaml.tmpl",
+			OutputPath:   "deploy/k8s/deployment.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		{
+			TemplatePath: "k8s/service.yaml.tmpl",
+			OutputPath:   "deploy/k8s/service.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		{
+			TemplatePath: "k8s/configmap.yaml.tmpl",
+			OutputPath:   "deploy/k8s/configmap.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseKubernetes && c.UseDocker },
+		},
+		{
+			TemplatePath: "standard/docker-compose.yaml.tmpl",
+			OutputPath:   "docker-compose.yaml",
+			Condition:    func(c ProjectConfig) bool { return c.UseDocker },
+		},
+		{
+			TemplatePath: "standard/github_ci.yaml.tmpl",
+			OutputPath:   ".github/workflows/ci.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "github" },
+		},
+		{
+			TemplatePath: "standard/gitlab_ci.yml.tmpl",
+			OutputPath:   ".gitlab-ci.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "gitlab" },
+		},
+		{
+			TemplatePath: "standard/circleci_config.yml.tmpl",
+			OutputPath:   ".circleci/config.yml",
+			Condition:    func(c ProjectConfig) bool { return ciProvider(c) == "circleci" },
 		},
 	}
 }
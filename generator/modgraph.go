@@ -0,0 +1,6865 @@
+package generator
+
+// moduleGraphs maps "module@version" to the go.sum lines a project needs
+// for it beyond the module's own. For a catalog dependency these are the rest
+// of what `go mod tidy` writes, minus the content hashes of its
+// indirectDependencies: the go.mod files of its module graph, which stay
+// needed when another dependency raises one of them, and the modules its
+// packages' tests need. For a module that predates module graph pruning
+// (go 1.17), which go.mod can end up requiring through moduleRequirements,
+// they are the go.mod files of its whole module graph, which the go command
+// loads along with it. Together with indirectDependencies they give a
+// generated project a complete go.mod and go.sum, so it builds without `go
+// mod tidy`. The hashes live in moduleChecksums.
+//
+// The catalog lists come from `go mod tidy` in a module importing the packages
+// the templates use from the dependency. Keys must match the versions pinned
+// in getDependencies; a dependency resolved to another version has no entry
+// and its graph is left to `go mod tidy`.
+var moduleGraphs = map[string][]string{
+	"dario.cat/mergo@v1.0.0": {
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/99designs/gqlgen@v0.17.45": {
+		"github.com/PuerkitoBio/goquery v1.9.1",
+		"github.com/PuerkitoBio/goquery v1.9.1/go.mod",
+		"github.com/agnivade/levenshtein v1.1.1/go.mod",
+		"github.com/andreyvit/diff v0.0.0-20170406064948-c7f18ee00883",
+		"github.com/andreyvit/diff v0.0.0-20170406064948-c7f18ee00883/go.mod",
+		"github.com/andybalholm/cascadia v1.3.2",
+		"github.com/andybalholm/cascadia v1.3.2/go.mod",
+		"github.com/arbovm/levenshtein v0.0.0-20160628152529-48b4e1c0c4d0",
+		"github.com/arbovm/levenshtein v0.0.0-20160628152529-48b4e1c0c4d0/go.mod",
+		"github.com/cpuguy83/go-md2man/v2 v2.0.2/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/dgryski/trifles v0.0.0-20200323201526-dd97f9abfb48",
+		"github.com/dgryski/trifles v0.0.0-20200323201526-dd97f9abfb48/go.mod",
+		"github.com/google/uuid v1.6.0/go.mod",
+		"github.com/gorilla/websocket v1.5.0/go.mod",
+		"github.com/hashicorp/golang-lru/v2 v2.0.7/go.mod",
+		"github.com/mitchellh/mapstructure v1.5.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/russross/blackfriday/v2 v2.1.0/go.mod",
+		"github.com/sergi/go-diff v1.3.1",
+		"github.com/sergi/go-diff v1.3.1/go.mod",
+		"github.com/sosodev/duration v1.2.0/go.mod",
+		"github.com/stretchr/testify v1.9.0",
+		"github.com/stretchr/testify v1.9.0/go.mod",
+		"github.com/urfave/cli/v2 v2.27.1/go.mod",
+		"github.com/vektah/gqlparser/v2 v2.5.11/go.mod",
+		"github.com/xrash/smetrics v0.0.0-20201216005158-039620a65673/go.mod",
+		"golang.org/x/mod v0.16.0/go.mod",
+		"golang.org/x/net v0.22.0",
+		"golang.org/x/net v0.22.0/go.mod",
+		"golang.org/x/sync v0.6.0",
+		"golang.org/x/sync v0.6.0/go.mod",
+		"golang.org/x/text v0.14.0/go.mod",
+		"golang.org/x/tools v0.19.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.4.0",
+		"gopkg.in/yaml.v2 v2.4.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/AthenZ/athenz@v1.10.39": {
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/ardielle/ardielle-go v1.5.2/go.mod",
+		"github.com/ardielle/ardielle-tools v1.5.4/go.mod",
+		"github.com/aws/aws-sdk-go v1.32.6/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/dimfeld/httptreemux v5.0.1+incompatible/go.mod",
+		"github.com/go-sql-driver/mysql v1.5.0/go.mod",
+		"github.com/golang-jwt/jwt v3.2.1+incompatible/go.mod",
+		"github.com/golang/mock v1.4.3/go.mod",
+		"github.com/google/go-cmp v0.5.1/go.mod",
+		"github.com/gorilla/mux v1.7.4/go.mod",
+		"github.com/jawher/mow.cli v1.0.4/go.mod",
+		"github.com/jawher/mow.cli v1.2.0/go.mod",
+		"github.com/jmespath/go-jmespath v0.3.0/go.mod",
+		"github.com/kr/pretty v0.2.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.2.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/stretchr/testify v1.5.1/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20190701094942-4def268fd1a4/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20200202094626-16171245cfb2/go.mod",
+		"golang.org/x/net v0.0.0-20210726213435-c6fcb2dbf985/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210423082822-04245dca01da/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/text v0.0.0-20170915032832-14c0d48ead0c/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.6/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15/go.mod",
+		"gopkg.in/natefinch/lumberjack.v2 v2.0.0/go.mod",
+		"gopkg.in/square/go-jose.v2 v2.4.1/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.2.5/go.mod",
+		"gopkg.in/yaml.v2 v2.3.0/go.mod",
+		"rsc.io/quote/v3 v3.1.0/go.mod",
+		"rsc.io/sampler v1.3.0/go.mod",
+	},
+	"github.com/Azure/go-ansiterm@v0.0.0-20210617225240-d185dfc1b5a1": {
+		"golang.org/x/sys v0.0.0-20210616094352-59db8d763f22/go.mod",
+	},
+	"github.com/Azure/go-ansiterm@v0.0.0-20230124172434-306776ec8161": {
+		"golang.org/x/sys v0.0.0-20210616094352-59db8d763f22/go.mod",
+	},
+	"github.com/IBM/sarama@v1.42.2": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/eapache/go-resiliency v1.5.0/go.mod",
+		"github.com/eapache/go-xerial-snappy v0.0.0-20230731223053-c322873962e3/go.mod",
+		"github.com/eapache/queue v1.1.0/go.mod",
+		"github.com/fortytw2/leaktest v1.3.0",
+		"github.com/fortytw2/leaktest v1.3.0/go.mod",
+		"github.com/golang/snappy v0.0.4/go.mod",
+		"github.com/gorilla/securecookie v1.1.1/go.mod",
+		"github.com/gorilla/sessions v1.2.1/go.mod",
+		"github.com/hashicorp/errwrap v1.0.0/go.mod",
+		"github.com/hashicorp/go-multierror v1.1.1/go.mod",
+		"github.com/hashicorp/go-uuid v1.0.2/go.mod",
+		"github.com/hashicorp/go-uuid v1.0.3/go.mod",
+		"github.com/jcmturner/aescts/v2 v2.0.0/go.mod",
+		"github.com/jcmturner/dnsutils/v2 v2.0.0/go.mod",
+		"github.com/jcmturner/gofork v1.7.6/go.mod",
+		"github.com/jcmturner/goidentity/v6 v6.0.1",
+		"github.com/jcmturner/goidentity/v6 v6.0.1/go.mod",
+		"github.com/jcmturner/gokrb5/v8 v8.4.4/go.mod",
+		"github.com/jcmturner/rpc/v2 v2.0.3/go.mod",
+		"github.com/klauspost/compress v1.16.7/go.mod",
+		"github.com/pierrec/lz4/v4 v4.1.21/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/rcrowley/go-metrics v0.0.0-20201227073835-cf1acfcdf475/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.1/go.mod",
+		"github.com/stretchr/testify v1.8.4",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"github.com/yuin/goldmark v1.4.13/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20210921155107-089bfa567519/go.mod",
+		"golang.org/x/crypto v0.6.0/go.mod",
+		"golang.org/x/crypto v0.19.0/go.mod",
+		"golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/net v0.0.0-20210226172049-e18ecbb05110/go.mod",
+		"golang.org/x/net v0.0.0-20220722155237-a158d28d115b/go.mod",
+		"golang.org/x/net v0.6.0/go.mod",
+		"golang.org/x/net v0.7.0/go.mod",
+		"golang.org/x/net v0.21.0/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20220722155255-886fb9371eb4/go.mod",
+		"golang.org/x/sync v0.6.0",
+		"golang.org/x/sync v0.6.0/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1/go.mod",
+		"golang.org/x/sys v0.0.0-20220520151302-bc2c85ada10a/go.mod",
+		"golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f/go.mod",
+		"golang.org/x/sys v0.5.0/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/term v0.0.0-20210927222741-03fcf44c2211/go.mod",
+		"golang.org/x/term v0.5.0/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.7/go.mod",
+		"golang.org/x/text v0.7.0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.1.12/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/a-h/templ@v0.2.543": {},
+	"github.com/agnivade/levenshtein@v1.1.1": {
+		"github.com/arbovm/levenshtein v0.0.0-20160628152529-48b4e1c0c4d0/go.mod",
+		"github.com/dgryski/trifles v0.0.0-20200323201526-dd97f9abfb48/go.mod",
+	},
+	"github.com/alecthomas/kong@v0.8.1": {
+		"github.com/alecthomas/assert/v2 v2.1.0",
+		"github.com/alecthomas/assert/v2 v2.1.0/go.mod",
+		"github.com/alecthomas/repr v0.1.0",
+		"github.com/alecthomas/repr v0.1.0/go.mod",
+		"github.com/hexops/gotextdiff v1.0.3",
+		"github.com/hexops/gotextdiff v1.0.3/go.mod",
+	},
+	"github.com/apache/pulsar-client-go@v0.12.0": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"cloud.google.com/go v0.34.0/go.mod",
+		"cloud.google.com/go v0.38.0/go.mod",
+		"cloud.google.com/go v0.44.1/go.mod",
+		"cloud.google.com/go v0.44.2/go.mod",
+		"cloud.google.com/go v0.45.1/go.mod",
+		"cloud.google.com/go v0.46.3/go.mod",
+		"cloud.google.com/go v0.50.0/go.mod",
+		"cloud.google.com/go v0.52.0/go.mod",
+		"cloud.google.com/go v0.53.0/go.mod",
+		"cloud.google.com/go v0.54.0/go.mod",
+		"cloud.google.com/go v0.56.0/go.mod",
+		"cloud.google.com/go v0.57.0/go.mod",
+		"cloud.google.com/go v0.62.0/go.mod",
+		"cloud.google.com/go v0.65.0/go.mod",
+		"cloud.google.com/go/bigquery v1.0.1/go.mod",
+		"cloud.google.com/go/bigquery v1.3.0/go.mod",
+		"cloud.google.com/go/bigquery v1.4.0/go.mod",
+		"cloud.google.com/go/bigquery v1.5.0/go.mod",
+		"cloud.google.com/go/bigquery v1.7.0/go.mod",
+		"cloud.google.com/go/bigquery v1.8.0/go.mod",
+		"cloud.google.com/go/datastore v1.0.0/go.mod",
+		"cloud.google.com/go/datastore v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.0.1/go.mod",
+		"cloud.google.com/go/pubsub v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.2.0/go.mod",
+		"cloud.google.com/go/pubsub v1.3.1/go.mod",
+		"cloud.google.com/go/storage v1.0.0/go.mod",
+		"cloud.google.com/go/storage v1.5.0/go.mod",
+		"cloud.google.com/go/storage v1.6.0/go.mod",
+		"cloud.google.com/go/storage v1.8.0/go.mod",
+		"cloud.google.com/go/storage v1.10.0/go.mod",
+		"dmitri.shuralyov.com/gpu/mtl v0.0.0-20190408044501-666a987793e9/go.mod",
+		"github.com/99designs/go-keychain v0.0.0-20191008050251-8e49817e8af4/go.mod",
+		"github.com/99designs/keyring v1.2.1/go.mod",
+		"github.com/AthenZ/athenz v1.10.39/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/BurntSushi/xgb v0.0.0-20160522181843-27f122750802/go.mod",
+		"github.com/DataDog/zstd v1.5.0/go.mod",
+		"github.com/alecthomas/template v0.0.0-20160405071501-a0175ee3bccc/go.mod",
+		"github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751/go.mod",
+		"github.com/alecthomas/units v0.0.0-20151022065526-2efee857e7cf/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190717042225-c3de453c63f4/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190924025748-f65c72e2690d/go.mod",
+		"github.com/ardielle/ardielle-go v1.5.2/go.mod",
+		"github.com/ardielle/ardielle-tools v1.5.4/go.mod",
+		"github.com/aws/aws-sdk-go v1.32.6/go.mod",
+		"github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973/go.mod",
+		"github.com/beorn7/perks v1.0.0/go.mod",
+		"github.com/beorn7/perks v1.0.1/go.mod",
+		"github.com/bits-and-blooms/bitset v1.4.0/go.mod",
+		"github.com/bmizerany/perks v0.0.0-20141205001514-d9a9656a3a4b/go.mod",
+		"github.com/census-instrumentation/opencensus-proto v0.2.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.1.1/go.mod",
+		"github.com/chzyer/logex v1.1.10/go.mod",
+		"github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e/go.mod",
+		"github.com/chzyer/test v0.0.0-20180213035817-a1ea475d72b1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/cncf/udpa/go v0.0.0-20191209042840-269d4d468f6f/go.mod",
+		"github.com/cpuguy83/go-md2man/v2 v2.0.2/go.mod",
+		"github.com/danieljoos/wincred v1.1.2/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/dimfeld/httptreemux v5.0.1+incompatible/go.mod",
+		"github.com/dvsekhvalnov/jose2go v1.6.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.1-0.20191026205805-5f8ba28d4473/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.4/go.mod",
+		"github.com/envoyproxy/protoc-gen-validate v0.1.0/go.mod",
+		"github.com/fsnotify/fsnotify v1.4.7/go.mod",
+		"github.com/fsnotify/fsnotify v1.4.9/go.mod",
+		"github.com/go-gl/glfw v0.0.0-20190409004039-e6da0acd62b1/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20191125211704-12ad95a8df72/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20200222043503-6f7a984d4dc4/go.mod",
+		"github.com/go-kit/kit v0.8.0/go.mod",
+		"github.com/go-kit/kit v0.9.0/go.mod",
+		"github.com/go-kit/log v0.1.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.3.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.4.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.5.0/go.mod",
+		"github.com/go-sql-driver/mysql v1.5.0/go.mod",
+		"github.com/go-stack/stack v1.8.0/go.mod",
+		"github.com/go-task/slim-sprig v0.0.0-20210107165309-348f09dbbbc0/go.mod",
+		"github.com/godbus/dbus v0.0.0-20190726142602-4481cbc300e2/go.mod",
+		"github.com/gogo/protobuf v1.1.1/go.mod",
+		"github.com/golang-jwt/jwt v3.2.1+incompatible/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6/go.mod",
+		"github.com/golang/groupcache v0.0.0-20191227052852-215e87163ea7/go.mod",
+		"github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/mock v1.2.0/go.mod",
+		"github.com/golang/mock v1.3.1/go.mod",
+		"github.com/golang/mock v1.4.0/go.mod",
+		"github.com/golang/mock v1.4.1/go.mod",
+		"github.com/golang/mock v1.4.3/go.mod",
+		"github.com/golang/mock v1.4.4/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.3.3/go.mod",
+		"github.com/golang/protobuf v1.3.4/go.mod",
+		"github.com/golang/protobuf v1.3.5/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.1/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/golang/protobuf v1.4.3/go.mod",
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/golang/protobuf v1.5.2/go.mod",
+		"github.com/golang/snappy v0.0.1/go.mod",
+		"github.com/google/btree v0.0.0-20180813153112-4030bb1f1f0c/go.mod",
+		"github.com/google/btree v1.0.0/go.mod",
+		"github.com/google/go-cmp v0.2.0/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.4.1/go.mod",
+		"github.com/google/go-cmp v0.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.1/go.mod",
+		"github.com/google/go-cmp v0.5.4/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/google/gofuzz v1.0.0/go.mod",
+		"github.com/google/martian v2.1.0+incompatible/go.mod",
+		"github.com/google/martian/v3 v3.0.0/go.mod",
+		"github.com/google/pprof v0.0.0-20181206194817-3ea8567a2e57/go.mod",
+		"github.com/google/pprof v0.0.0-20190515194954-54271f7e092f/go.mod",
+		"github.com/google/pprof v0.0.0-20191218002539-d4f498aebedc/go.mod",
+		"github.com/google/pprof v0.0.0-20200212024743-f11f1df84d12/go.mod",
+		"github.com/google/pprof v0.0.0-20200229191704-1ebb73c60ed3/go.mod",
+		"github.com/google/pprof v0.0.0-20200430221834-fc25d7d30c6d/go.mod",
+		"github.com/google/pprof v0.0.0-20200708004538-1a94d8640e99/go.mod",
+		"github.com/google/renameio v0.1.0/go.mod",
+		"github.com/google/uuid v1.1.2/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.4/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.5/go.mod",
+		"github.com/gorilla/mux v1.7.4/go.mod",
+		"github.com/gsterjov/go-libsecret v0.0.0-20161001094733-a6f4afe4910c/go.mod",
+		"github.com/hashicorp/errwrap v1.0.0/go.mod",
+		"github.com/hashicorp/go-multierror v1.1.1/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.0/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.1/go.mod",
+		"github.com/hpcloud/tail v1.0.0/go.mod",
+		"github.com/ianlancetaylor/demangle v0.0.0-20181102032728-5e5cf60278f6/go.mod",
+		"github.com/inconshreveable/mousetrap v1.0.1/go.mod",
+		"github.com/jawher/mow.cli v1.0.4/go.mod",
+		"github.com/jawher/mow.cli v1.2.0/go.mod",
+		"github.com/jmespath/go-jmespath v0.3.0/go.mod",
+		"github.com/jpillora/backoff v1.0.0/go.mod",
+		"github.com/json-iterator/go v1.1.6/go.mod",
+		"github.com/json-iterator/go v1.1.10/go.mod",
+		"github.com/json-iterator/go v1.1.11/go.mod",
+		"github.com/jstemmer/go-junit-report v0.0.0-20190106144839-af01ea7f8024/go.mod",
+		"github.com/jstemmer/go-junit-report v0.9.1/go.mod",
+		"github.com/julienschmidt/httprouter v1.2.0/go.mod",
+		"github.com/julienschmidt/httprouter v1.3.0/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/klauspost/compress v1.14.4/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.1/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.3/go.mod",
+		"github.com/kr/logfmt v0.0.0-20140226030751-b84e30acd515/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pretty v0.2.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/linkedin/goavro/v2 v2.9.8/go.mod",
+		"github.com/matttproud/golang_protobuf_extensions v1.0.1/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180228061459-e0a39a4cb421/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd/go.mod",
+		"github.com/modern-go/reflect2 v0.0.0-20180701023420-4b7aa43c6742/go.mod",
+		"github.com/modern-go/reflect2 v1.0.1/go.mod",
+		"github.com/mtibben/percent v0.2.1/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20161129095857-cc309e4a2223/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20190716064945-2f068394615f/go.mod",
+		"github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e/go.mod",
+		"github.com/nxadm/tail v1.4.4/go.mod",
+		"github.com/nxadm/tail v1.4.8/go.mod",
+		"github.com/onsi/ginkgo v1.6.0/go.mod",
+		"github.com/onsi/ginkgo v1.12.1/go.mod",
+		"github.com/onsi/ginkgo v1.16.5/go.mod",
+		"github.com/onsi/ginkgo/v2 v2.1.3/go.mod",
+		"github.com/onsi/gomega v1.7.1/go.mod",
+		"github.com/onsi/gomega v1.10.1/go.mod",
+		"github.com/onsi/gomega v1.19.0/go.mod",
+		"github.com/opentracing/opentracing-go v1.2.0/go.mod",
+		"github.com/pierrec/lz4 v2.0.5+incompatible/go.mod",
+		"github.com/pkg/errors v0.8.0/go.mod",
+		"github.com/pkg/errors v0.8.1/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v0.9.1/go.mod",
+		"github.com/prometheus/client_golang v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v1.7.1/go.mod",
+		"github.com/prometheus/client_golang v1.11.1/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20180712105110-5c3871d89910/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190129233127-fd36f4220a90/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4/go.mod",
+		"github.com/prometheus/client_model v0.2.0/go.mod",
+		"github.com/prometheus/common v0.4.1/go.mod",
+		"github.com/prometheus/common v0.10.0/go.mod",
+		"github.com/prometheus/common v0.26.0/go.mod",
+		"github.com/prometheus/procfs v0.0.0-20181005140218-185b4288413d/go.mod",
+		"github.com/prometheus/procfs v0.0.2/go.mod",
+		"github.com/prometheus/procfs v0.1.3/go.mod",
+		"github.com/prometheus/procfs v0.6.0/go.mod",
+		"github.com/rogpeppe/go-internal v1.3.0/go.mod",
+		"github.com/russross/blackfriday/v2 v2.1.0/go.mod",
+		"github.com/sirupsen/logrus v1.2.0/go.mod",
+		"github.com/sirupsen/logrus v1.4.2/go.mod",
+		"github.com/sirupsen/logrus v1.6.0/go.mod",
+		"github.com/spaolacci/murmur3 v1.1.0/go.mod",
+		"github.com/spf13/cobra v1.6.1/go.mod",
+		"github.com/spf13/pflag v1.0.5/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.1.1/go.mod",
+		"github.com/stretchr/objx v0.2.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/testify v1.2.2/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/stretchr/testify v1.5.1/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/yuin/goldmark v1.1.25/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.1.32/go.mod",
+		"github.com/yuin/goldmark v1.2.1/go.mod",
+		"go.opencensus.io v0.21.0/go.mod",
+		"go.opencensus.io v0.22.0/go.mod",
+		"go.opencensus.io v0.22.2/go.mod",
+		"go.opencensus.io v0.22.3/go.mod",
+		"go.opencensus.io v0.22.4/go.mod",
+		"go.uber.org/atomic v1.7.0/go.mod",
+		"golang.org/x/crypto v0.0.0-20180904163835-0709b304e793/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20190510104115-cbcb75029529/go.mod",
+		"golang.org/x/crypto v0.0.0-20190605123033-f99c8df09eb5/go.mod",
+		"golang.org/x/crypto v0.0.0-20190701094942-4def268fd1a4/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/crypto v0.14.0/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/exp v0.0.0-20190306152737-a1d7652674e8/go.mod",
+		"golang.org/x/exp v0.0.0-20190510132918-efd6b22b2522/go.mod",
+		"golang.org/x/exp v0.0.0-20190829153037-c13cbed26979/go.mod",
+		"golang.org/x/exp v0.0.0-20191030013958-a1ab85dbe136/go.mod",
+		"golang.org/x/exp v0.0.0-20191129062945-2f5052295587/go.mod",
+		"golang.org/x/exp v0.0.0-20191227195350-da58074b4299/go.mod",
+		"golang.org/x/exp v0.0.0-20200119233911-0405dc783f0a/go.mod",
+		"golang.org/x/exp v0.0.0-20200207192155-f17229e696bd/go.mod",
+		"golang.org/x/exp v0.0.0-20200224162631-6cc2880d07d6/go.mod",
+		"golang.org/x/image v0.0.0-20190227222117-0694c2d4d067/go.mod",
+		"golang.org/x/image v0.0.0-20190802002840-cff245a6509b/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190301231843-5614ed5bae6f/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/lint v0.0.0-20190409202823-959b441ac422/go.mod",
+		"golang.org/x/lint v0.0.0-20190909230951-414d861bb4ac/go.mod",
+		"golang.org/x/lint v0.0.0-20190930215403-16217165b5de/go.mod",
+		"golang.org/x/lint v0.0.0-20191125180803-fdd1cda4f05f/go.mod",
+		"golang.org/x/lint v0.0.0-20200130185559-910be7a94367/go.mod",
+		"golang.org/x/lint v0.0.0-20200302205851-738671d3881b/go.mod",
+		"golang.org/x/mobile v0.0.0-20190312151609-d3739f865fa6/go.mod",
+		"golang.org/x/mobile v0.0.0-20190719004257-d2bd2a29d028/go.mod",
+		"golang.org/x/mod v0.0.0-20190513183733-4bf6d317e70e/go.mod",
+		"golang.org/x/mod v0.1.0/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191105210325-c90efee705ee/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191107180719-034126e5016b/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/mod v0.8.0/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20180906233101-161cd47e91fd/go.mod",
+		"golang.org/x/net v0.0.0-20181114220301-adae6a3d119a/go.mod",
+		"golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190501004415-9ce7a6920f09/go.mod",
+		"golang.org/x/net v0.0.0-20190503192946-f4e77d36d62c/go.mod",
+		"golang.org/x/net v0.0.0-20190603091049-60506f45cf65/go.mod",
+		"golang.org/x/net v0.0.0-20190613194153-d28f0bde5980/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20190628185345-da137c7871d7/go.mod",
+		"golang.org/x/net v0.0.0-20190724013045-ca1201d0de80/go.mod",
+		"golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/net v0.0.0-20200202094626-16171245cfb2/go.mod",
+		"golang.org/x/net v0.0.0-20200222125558-5a598a2470a0/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20200301022130-244492dfa37a/go.mod",
+		"golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e/go.mod",
+		"golang.org/x/net v0.0.0-20200501053045-e0ff5e5a1de5/go.mod",
+		"golang.org/x/net v0.0.0-20200506145744-7e3656a0809f/go.mod",
+		"golang.org/x/net v0.0.0-20200513185701-a91f0712d120/go.mod",
+		"golang.org/x/net v0.0.0-20200520004742-59133d7f0dd7/go.mod",
+		"golang.org/x/net v0.0.0-20200520182314-0ba52f642ac2/go.mod",
+		"golang.org/x/net v0.0.0-20200625001655-4c5254603344/go.mod",
+		"golang.org/x/net v0.0.0-20200707034311-ab3426394381/go.mod",
+		"golang.org/x/net v0.0.0-20200822124328-c89045814202/go.mod",
+		"golang.org/x/net v0.0.0-20201021035429-f5854403a974/go.mod",
+		"golang.org/x/net v0.0.0-20210726213435-c6fcb2dbf985/go.mod",
+		"golang.org/x/net v0.17.0/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190226205417-e64efc72b421/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20191202225959-858c2ad4c8b6/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20210402161424-2e8d93401602/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+		"golang.org/x/sync v0.0.0-20190227155943-e225da77a7e6/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20200317015054-43a5402ce75a/go.mod",
+		"golang.org/x/sync v0.0.0-20200625203802-6e8e738ad208/go.mod",
+		"golang.org/x/sync v0.0.0-20201020160332-67f06af15bc9/go.mod",
+		"golang.org/x/sync v0.0.0-20201207232520-09787c993a3a/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20180905080454-ebe1bf3edb33/go.mod",
+		"golang.org/x/sys v0.0.0-20180909124046-d0be0721c37e/go.mod",
+		"golang.org/x/sys v0.0.0-20181116152217-5ac8a444bdc5/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190312061237-fead79001313/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190422165155-953cdadca894/go.mod",
+		"golang.org/x/sys v0.0.0-20190502145724-3ef323f4f1fd/go.mod",
+		"golang.org/x/sys v0.0.0-20190507160741-ecd444e8653b/go.mod",
+		"golang.org/x/sys v0.0.0-20190606165138-5da285871e9c/go.mod",
+		"golang.org/x/sys v0.0.0-20190624142023-c5567b49c5d0/go.mod",
+		"golang.org/x/sys v0.0.0-20190726091711-fc99dfbffb4e/go.mod",
+		"golang.org/x/sys v0.0.0-20190904154756-749cb33beabd/go.mod",
+		"golang.org/x/sys v0.0.0-20191001151750-bb3f8db39f24/go.mod",
+		"golang.org/x/sys v0.0.0-20191005200804-aed5e4c7ecf9/go.mod",
+		"golang.org/x/sys v0.0.0-20191120155948-bd437916bb0e/go.mod",
+		"golang.org/x/sys v0.0.0-20191204072324-ce4227a45e2e/go.mod",
+		"golang.org/x/sys v0.0.0-20191228213918-04cbcbbfeed8/go.mod",
+		"golang.org/x/sys v0.0.0-20200106162015-b016eb3dc98e/go.mod",
+		"golang.org/x/sys v0.0.0-20200113162924-86b910548bc1/go.mod",
+		"golang.org/x/sys v0.0.0-20200122134326-e047566fdf82/go.mod",
+		"golang.org/x/sys v0.0.0-20200202164722-d101bd2416d5/go.mod",
+		"golang.org/x/sys v0.0.0-20200212091648-12a6c2dcc1e4/go.mod",
+		"golang.org/x/sys v0.0.0-20200223170610-d5e6a3e2c0ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200302150141-5c8b2ff67527/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200331124033-c3d80250170d/go.mod",
+		"golang.org/x/sys v0.0.0-20200501052902-10377860bb8e/go.mod",
+		"golang.org/x/sys v0.0.0-20200511232937-7e40ca221e25/go.mod",
+		"golang.org/x/sys v0.0.0-20200515095857-1151b9dac4a9/go.mod",
+		"golang.org/x/sys v0.0.0-20200523222454-059865788121/go.mod",
+		"golang.org/x/sys v0.0.0-20200615200032-f1bc736245b1/go.mod",
+		"golang.org/x/sys v0.0.0-20200625212154-ddb9806d33ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200803210538-64077c9b5642/go.mod",
+		"golang.org/x/sys v0.0.0-20200930185726-fdedc70b468f/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210112080510-489259a85091/go.mod",
+		"golang.org/x/sys v0.0.0-20210124154548-22da62e12c0c/go.mod",
+		"golang.org/x/sys v0.0.0-20210423082822-04245dca01da/go.mod",
+		"golang.org/x/sys v0.0.0-20210603081109-ebe580a85c40/go.mod",
+		"golang.org/x/sys v0.0.0-20210819135213-f52c844e1c1c/go.mod",
+		"golang.org/x/sys v0.13.0/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/term v0.13.0/go.mod",
+		"golang.org/x/text v0.0.0-20170915032832-14c0d48ead0c/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.1-0.20180807135948-17ff2d5776d2/go.mod",
+		"golang.org/x/text v0.3.2/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.6/go.mod",
+		"golang.org/x/text v0.13.0/go.mod",
+		"golang.org/x/time v0.0.0-20181108054448-85acf8d2951c/go.mod",
+		"golang.org/x/time v0.0.0-20190308202827-9d24e82272b4/go.mod",
+		"golang.org/x/time v0.0.0-20191024005414-555d28b269f0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20190312151545-0bb0c0a6e846/go.mod",
+		"golang.org/x/tools v0.0.0-20190312170243-e65039ee4138/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+		"golang.org/x/tools v0.0.0-20190506145303-2d16b83fe98c/go.mod",
+		"golang.org/x/tools v0.0.0-20190524140312-2c0ae7006135/go.mod",
+		"golang.org/x/tools v0.0.0-20190606124116-d0a3d012864b/go.mod",
+		"golang.org/x/tools v0.0.0-20190621195816-6e04913cbbac/go.mod",
+		"golang.org/x/tools v0.0.0-20190628153133-6cdbf07be9d0/go.mod",
+		"golang.org/x/tools v0.0.0-20190816200558-6889da9d5479/go.mod",
+		"golang.org/x/tools v0.0.0-20190911174233-4f2ddba30aff/go.mod",
+		"golang.org/x/tools v0.0.0-20191012152004-8de300cfc20a/go.mod",
+		"golang.org/x/tools v0.0.0-20191113191852-77e3bb0ad9e7/go.mod",
+		"golang.org/x/tools v0.0.0-20191115202509-3a792d9c32b2/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20191125144606-a911d9008d1f/go.mod",
+		"golang.org/x/tools v0.0.0-20191130070609-6e064ea0cf2d/go.mod",
+		"golang.org/x/tools v0.0.0-20191216173652-a0e659d51361/go.mod",
+		"golang.org/x/tools v0.0.0-20191227053925-7b8e75db28f4/go.mod",
+		"golang.org/x/tools v0.0.0-20200117161641-43d50277825c/go.mod",
+		"golang.org/x/tools v0.0.0-20200122220014-bf1340f18c4a/go.mod",
+		"golang.org/x/tools v0.0.0-20200130002326-2f3ba24bd6e7/go.mod",
+		"golang.org/x/tools v0.0.0-20200204074204-1cc6d1ef6c74/go.mod",
+		"golang.org/x/tools v0.0.0-20200207183749-b753a1ba74fa/go.mod",
+		"golang.org/x/tools v0.0.0-20200212150539-ea181f53ac56/go.mod",
+		"golang.org/x/tools v0.0.0-20200224181240-023911ca70b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200227222343-706bc42d1f0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200304193943-95d2e580d8eb/go.mod",
+		"golang.org/x/tools v0.0.0-20200312045724-11d5b4c81c7d/go.mod",
+		"golang.org/x/tools v0.0.0-20200331025713-a30bf2db82d4/go.mod",
+		"golang.org/x/tools v0.0.0-20200501065659-ab2804fb9c9d/go.mod",
+		"golang.org/x/tools v0.0.0-20200512131952-2bc93b1c0c88/go.mod",
+		"golang.org/x/tools v0.0.0-20200515010526-7d3b6ebf133d/go.mod",
+		"golang.org/x/tools v0.0.0-20200618134242-20370b0cb4b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200729194436-6467de6f59a7/go.mod",
+		"golang.org/x/tools v0.0.0-20200804011535-6c149bb5ef0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200825202427-b303f430e36d/go.mod",
+		"golang.org/x/tools v0.0.0-20201224043029-2b0845dc783e/go.mod",
+		"golang.org/x/tools v0.6.0/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/api v0.4.0/go.mod",
+		"google.golang.org/api v0.7.0/go.mod",
+		"google.golang.org/api v0.8.0/go.mod",
+		"google.golang.org/api v0.9.0/go.mod",
+		"google.golang.org/api v0.13.0/go.mod",
+		"google.golang.org/api v0.14.0/go.mod",
+		"google.golang.org/api v0.15.0/go.mod",
+		"google.golang.org/api v0.17.0/go.mod",
+		"google.golang.org/api v0.18.0/go.mod",
+		"google.golang.org/api v0.19.0/go.mod",
+		"google.golang.org/api v0.20.0/go.mod",
+		"google.golang.org/api v0.22.0/go.mod",
+		"google.golang.org/api v0.24.0/go.mod",
+		"google.golang.org/api v0.28.0/go.mod",
+		"google.golang.org/api v0.29.0/go.mod",
+		"google.golang.org/api v0.30.0/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/appengine v1.5.0/go.mod",
+		"google.golang.org/appengine v1.6.1/go.mod",
+		"google.golang.org/appengine v1.6.5/go.mod",
+		"google.golang.org/appengine v1.6.6/go.mod",
+		"google.golang.org/appengine v1.6.7/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190307195333-5fe7a883aa19/go.mod",
+		"google.golang.org/genproto v0.0.0-20190418145605-e7d98fc518a7/go.mod",
+		"google.golang.org/genproto v0.0.0-20190425155659-357c62f0e4bb/go.mod",
+		"google.golang.org/genproto v0.0.0-20190502173448-54afdca5d873/go.mod",
+		"google.golang.org/genproto v0.0.0-20190801165951-fa694d86fc64/go.mod",
+		"google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55/go.mod",
+		"google.golang.org/genproto v0.0.0-20190911173649-1774047e7e51/go.mod",
+		"google.golang.org/genproto v0.0.0-20191108220845-16a3f7862a1a/go.mod",
+		"google.golang.org/genproto v0.0.0-20191115194625-c23dd37a84c9/go.mod",
+		"google.golang.org/genproto v0.0.0-20191216164720-4f79533eabd1/go.mod",
+		"google.golang.org/genproto v0.0.0-20191230161307-f3c370f40bfb/go.mod",
+		"google.golang.org/genproto v0.0.0-20200115191322-ca5a22157cba/go.mod",
+		"google.golang.org/genproto v0.0.0-20200122232147-0452cf42e150/go.mod",
+		"google.golang.org/genproto v0.0.0-20200204135345-fa8e72b47b90/go.mod",
+		"google.golang.org/genproto v0.0.0-20200212174721-66ed5ce911ce/go.mod",
+		"google.golang.org/genproto v0.0.0-20200224152610-e50cd9704f63/go.mod",
+		"google.golang.org/genproto v0.0.0-20200228133532-8c2c7df3a383/go.mod",
+		"google.golang.org/genproto v0.0.0-20200305110556-506484158171/go.mod",
+		"google.golang.org/genproto v0.0.0-20200312145019-da6875a35672/go.mod",
+		"google.golang.org/genproto v0.0.0-20200331122359-1ee6d9798940/go.mod",
+		"google.golang.org/genproto v0.0.0-20200430143042-b979b6f78d84/go.mod",
+		"google.golang.org/genproto v0.0.0-20200511104702-f5ebc3bea380/go.mod",
+		"google.golang.org/genproto v0.0.0-20200515170657-fc4c6c6a6587/go.mod",
+		"google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013/go.mod",
+		"google.golang.org/genproto v0.0.0-20200618031413-b414f8b61790/go.mod",
+		"google.golang.org/genproto v0.0.0-20200729003335-053ba62fc06f/go.mod",
+		"google.golang.org/genproto v0.0.0-20200804131852-c06518451d9c/go.mod",
+		"google.golang.org/genproto v0.0.0-20200825200019-8632dd797987/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.20.1/go.mod",
+		"google.golang.org/grpc v1.21.1/go.mod",
+		"google.golang.org/grpc v1.23.0/go.mod",
+		"google.golang.org/grpc v1.25.1/go.mod",
+		"google.golang.org/grpc v1.26.0/go.mod",
+		"google.golang.org/grpc v1.27.0/go.mod",
+		"google.golang.org/grpc v1.27.1/go.mod",
+		"google.golang.org/grpc v1.28.0/go.mod",
+		"google.golang.org/grpc v1.29.1/go.mod",
+		"google.golang.org/grpc v1.30.0/go.mod",
+		"google.golang.org/grpc v1.31.0/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.22.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.23.1-0.20200526195155-81db48ad09cc/go.mod",
+		"google.golang.org/protobuf v1.24.0/go.mod",
+		"google.golang.org/protobuf v1.25.0/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.26.0/go.mod",
+		"google.golang.org/protobuf v1.30.0/go.mod",
+		"gopkg.in/alecthomas/kingpin.v2 v2.2.6/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20200902074654-038fdea0a05b/go.mod",
+		"gopkg.in/errgo.v2 v2.1.0/go.mod",
+		"gopkg.in/fsnotify.v1 v1.4.7/go.mod",
+		"gopkg.in/natefinch/lumberjack.v2 v2.0.0/go.mod",
+		"gopkg.in/square/go-jose.v2 v2.4.1/go.mod",
+		"gopkg.in/tomb.v1 v1.0.0-20141024135613-dd632973f1e7/go.mod",
+		"gopkg.in/yaml.v2 v2.2.1/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.2.4/go.mod",
+		"gopkg.in/yaml.v2 v2.2.5/go.mod",
+		"gopkg.in/yaml.v2 v2.3.0/go.mod",
+		"gopkg.in/yaml.v2 v2.4.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+		"honnef.co/go/tools v0.0.0-20190106161140-3f1c8253044a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190418001031-e561f6794a2a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190523083050-ea95bdfd59fc/go.mod",
+		"honnef.co/go/tools v0.0.1-2019.2.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.4/go.mod",
+		"rsc.io/binaryregexp v0.2.0/go.mod",
+		"rsc.io/quote/v3 v3.1.0/go.mod",
+		"rsc.io/sampler v1.3.0/go.mod",
+	},
+	"github.com/aws/aws-sdk-go-v2/service/sqs@v1.29.7": {
+		"github.com/aws/aws-sdk-go-v2 v1.24.1/go.mod",
+		"github.com/aws/aws-sdk-go-v2/internal/configsources v1.2.10/go.mod",
+		"github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.5.10/go.mod",
+		"github.com/aws/smithy-go v1.19.0/go.mod",
+		"github.com/google/go-cmp v0.5.8/go.mod",
+		"github.com/jmespath/go-jmespath v0.4.0/go.mod",
+	},
+	"github.com/bytedance/sonic@v1.9.1": {
+		"github.com/bytedance/sonic v1.5.0/go.mod",
+		"github.com/chenzhuoyu/base64x v0.0.0-20211019084208-fb5309c8db06/go.mod",
+		"github.com/chenzhuoyu/base64x v0.0.0-20221115062448-fe3a3abad311/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/klauspost/cpuid/v2 v2.0.9/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.1/go.mod",
+		"github.com/twitchyliquid64/golang-asm v0.15.1/go.mod",
+		"golang.org/x/arch v0.0.0-20210923205945-b76863e36670/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+		"rsc.io/pdf v0.1.1/go.mod",
+	},
+	"github.com/casbin/casbin/v2@v2.82.0": {
+		"github.com/casbin/govaluate v1.1.0/go.mod",
+		"github.com/golang/mock v1.4.4/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+	},
+	"github.com/charmbracelet/bubbletea@v0.25.0": {
+		"github.com/aymanbagabas/go-osc52/v2 v2.0.1/go.mod",
+		"github.com/containerd/console v1.0.4-0.20230313162750-1ae8d489ac81/go.mod",
+		"github.com/lucasb-eyer/go-colorful v1.2.0/go.mod",
+		"github.com/mattn/go-isatty v0.0.18/go.mod",
+		"github.com/mattn/go-localereader v0.0.1/go.mod",
+		"github.com/mattn/go-runewidth v0.0.12/go.mod",
+		"github.com/mattn/go-runewidth v0.0.14/go.mod",
+		"github.com/muesli/ansi v0.0.0-20211018074035-2e021307bc4b/go.mod",
+		"github.com/muesli/cancelreader v0.2.2/go.mod",
+		"github.com/muesli/reflow v0.3.0/go.mod",
+		"github.com/muesli/termenv v0.15.2/go.mod",
+		"github.com/rivo/uniseg v0.1.0/go.mod",
+		"github.com/rivo/uniseg v0.2.0/go.mod",
+		"golang.org/x/sync v0.1.0/go.mod",
+		"golang.org/x/sys v0.1.0/go.mod",
+		"golang.org/x/sys v0.6.0/go.mod",
+		"golang.org/x/sys v0.7.0/go.mod",
+		"golang.org/x/term v0.6.0/go.mod",
+		"golang.org/x/text v0.3.8/go.mod",
+	},
+	"github.com/chenzhuoyu/base64x@v0.0.0-20221115062448-fe3a3abad311": {
+		"github.com/bytedance/sonic v1.5.0/go.mod",
+		"github.com/chenzhuoyu/base64x v0.0.0-20211019084208-fb5309c8db06/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/klauspost/cpuid/v2 v2.0.9/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.1/go.mod",
+		"github.com/twitchyliquid64/golang-asm v0.15.1/go.mod",
+		"golang.org/x/arch v0.0.0-20210923205945-b76863e36670/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+		"rsc.io/pdf v0.1.1/go.mod",
+	},
+	"github.com/confluentinc/confluent-kafka-go/v2@v2.3.0": {},
+	"github.com/containerd/console@v1.0.4-0.20230313162750-1ae8d489ac81": {
+		"golang.org/x/sys v0.1.0/go.mod",
+	},
+	"github.com/cpuguy83/go-md2man/v2@v2.0.2": {
+		"github.com/russross/blackfriday/v2 v2.1.0/go.mod",
+	},
+	"github.com/cpuguy83/go-md2man/v2@v2.0.3": {
+		"github.com/russross/blackfriday/v2 v2.1.0/go.mod",
+	},
+	"github.com/danieljoos/wincred@v1.1.2": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"golang.org/x/sys v0.0.0-20210819135213-f52c844e1c1c/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+	},
+	"github.com/dgraph-io/badger/v4@v4.2.0": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.1.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.2.0/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/dgraph-io/ristretto v0.1.1/go.mod",
+		"github.com/dgryski/go-farm v0.0.0-20190423205320-6a90982ecee2",
+		"github.com/dgryski/go-farm v0.0.0-20190423205320-6a90982ecee2/go.mod",
+		"github.com/dustin/go-humanize v1.0.0/go.mod",
+		"github.com/gogo/protobuf v1.3.2/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/glog v1.0.0/go.mod",
+		"github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/golang/protobuf v1.5.2/go.mod",
+		"github.com/golang/snappy v0.0.3/go.mod",
+		"github.com/google/flatbuffers v1.12.1/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.5.5",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/kisielk/errcheck v1.5.0/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/klauspost/compress v1.12.3/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.4.0",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.2.1/go.mod",
+		"go.opencensus.io v0.22.5/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20201021035429-f5854403a974/go.mod",
+		"golang.org/x/net v0.7.0/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20190227155943-e225da77a7e6/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20201020160332-67f06af15bc9/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190502145724-3ef323f4f1fd/go.mod",
+		"golang.org/x/sys v0.0.0-20200930185726-fdedc70b468f/go.mod",
+		"golang.org/x/sys v0.0.0-20221010170243-090e33056c14/go.mod",
+		"golang.org/x/sys v0.5.0/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20200619180055-7c47624df98f/go.mod",
+		"golang.org/x/tools v0.0.0-20210106214847-113979e3529a/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190425155659-357c62f0e4bb/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.20.1/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.26.0/go.mod",
+		"google.golang.org/protobuf v1.28.1/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+	},
+	"github.com/dgraph-io/ristretto@v0.1.1": {
+		"github.com/cespare/xxhash/v2 v2.1.1/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/dgryski/go-farm v0.0.0-20190423205320-6a90982ecee2/go.mod",
+		"github.com/dustin/go-humanize v1.0.0/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"golang.org/x/sys v0.0.0-20221010170243-090e33056c14/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+	},
+	"github.com/dvsekhvalnov/jose2go@v1.6.0": {
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20200902074654-038fdea0a05b/go.mod",
+	},
+	"github.com/flosch/pongo2/v6@v6.0.0": {
+		"github.com/kr/pretty v0.2.1",
+		"github.com/kr/pretty v0.2.1/go.mod",
+		"github.com/kr/text v0.1.0",
+		"github.com/kr/text v0.1.0/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c",
+		"gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c/go.mod",
+	},
+	"github.com/fsnotify/fsnotify@v1.4.9": {
+		"golang.org/x/sys v0.0.0-20191005200804-aed5e4c7ecf9/go.mod",
+	},
+	"github.com/fsnotify/fsnotify@v1.6.0": {
+		"golang.org/x/sys v0.0.0-20220908164124-27713097b956/go.mod",
+	},
+	"github.com/gin-contrib/sse@v0.1.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+	},
+	"github.com/gin-gonic/gin@v1.9.1": {
+		"github.com/bytedance/sonic v1.5.0/go.mod",
+		"github.com/bytedance/sonic v1.9.1/go.mod",
+		"github.com/chenzhuoyu/base64x v0.0.0-20211019084208-fb5309c8db06/go.mod",
+		"github.com/chenzhuoyu/base64x v0.0.0-20221115062448-fe3a3abad311/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/gabriel-vasile/mimetype v1.4.2/go.mod",
+		"github.com/gin-contrib/sse v0.1.0/go.mod",
+		"github.com/go-playground/assert/v2 v2.2.0",
+		"github.com/go-playground/assert/v2 v2.2.0/go.mod",
+		"github.com/go-playground/locales v0.14.1/go.mod",
+		"github.com/go-playground/universal-translator v0.18.1/go.mod",
+		"github.com/go-playground/validator/v10 v10.14.0/go.mod",
+		"github.com/goccy/go-json v0.10.2/go.mod",
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.5",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/google/gofuzz v1.0.0/go.mod",
+		"github.com/json-iterator/go v1.1.12/go.mod",
+		"github.com/klauspost/cpuid/v2 v2.0.9/go.mod",
+		"github.com/klauspost/cpuid/v2 v2.2.4/go.mod",
+		"github.com/leodido/go-urn v1.2.4/go.mod",
+		"github.com/mattn/go-isatty v0.0.19/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180228061459-e0a39a4cb421/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd/go.mod",
+		"github.com/modern-go/reflect2 v1.0.2/go.mod",
+		"github.com/pelletier/go-toml/v2 v2.0.8/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.1/go.mod",
+		"github.com/stretchr/testify v1.8.2/go.mod",
+		"github.com/stretchr/testify v1.8.3",
+		"github.com/stretchr/testify v1.8.3/go.mod",
+		"github.com/twitchyliquid64/golang-asm v0.15.1/go.mod",
+		"github.com/ugorji/go/codec v1.2.11/go.mod",
+		"golang.org/x/arch v0.0.0-20210923205945-b76863e36670/go.mod",
+		"golang.org/x/arch v0.3.0/go.mod",
+		"golang.org/x/crypto v0.9.0/go.mod",
+		"golang.org/x/net v0.10.0/go.mod",
+		"golang.org/x/sys v0.0.0-20220704084225-05e143d24a9e/go.mod",
+		"golang.org/x/sys v0.6.0/go.mod",
+		"golang.org/x/sys v0.8.0/go.mod",
+		"golang.org/x/text v0.9.0/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.30.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+		"rsc.io/pdf v0.1.1/go.mod",
+	},
+	"github.com/go-chi/chi/v5@v5.0.11": {},
+	"github.com/go-logr/stdr@v1.2.2": {
+		"github.com/go-logr/logr v1.2.2/go.mod",
+	},
+	"github.com/go-ole/go-ole@v1.2.6": {
+		"golang.org/x/sys v0.0.0-20190916202348-b4ddaad3f8a3/go.mod",
+	},
+	"github.com/go-openapi/jsonpointer@v0.19.5": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-openapi/swag v0.19.5/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/mailru/easyjson v0.0.0-20190614124828-94de47d64c63/go.mod",
+		"github.com/mailru/easyjson v0.0.0-20190626092158-b2ccc519800e/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+	},
+	"github.com/go-openapi/jsonreference@v0.20.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-openapi/jsonpointer v0.19.3/go.mod",
+		"github.com/go-openapi/swag v0.19.5/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/mailru/easyjson v0.0.0-20190614124828-94de47d64c63/go.mod",
+		"github.com/mailru/easyjson v0.0.0-20190626092158-b2ccc519800e/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+	},
+	"github.com/go-openapi/spec@v0.20.6": {
+		"github.com/creack/pty v1.1.9/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-openapi/jsonpointer v0.19.3/go.mod",
+		"github.com/go-openapi/jsonpointer v0.19.5/go.mod",
+		"github.com/go-openapi/jsonreference v0.20.0/go.mod",
+		"github.com/go-openapi/swag v0.19.5/go.mod",
+		"github.com/go-openapi/swag v0.19.15/go.mod",
+		"github.com/josharian/intern v1.0.0/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/kr/text v0.2.0/go.mod",
+		"github.com/mailru/easyjson v0.0.0-20190614124828-94de47d64c63/go.mod",
+		"github.com/mailru/easyjson v0.0.0-20190626092158-b2ccc519800e/go.mod",
+		"github.com/mailru/easyjson v0.7.6/go.mod",
+		"github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.6.1/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.4.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776/go.mod",
+	},
+	"github.com/go-openapi/swag@v0.19.15": {
+		"github.com/creack/pty v1.1.9/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/josharian/intern v1.0.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/kr/text v0.2.0/go.mod",
+		"github.com/mailru/easyjson v0.7.6/go.mod",
+		"github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.6.1/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f/go.mod",
+		"gopkg.in/yaml.v2 v2.4.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776/go.mod",
+	},
+	"github.com/go-sql-driver/mysql@v1.7.1": {},
+	"github.com/go-task/slim-sprig@v0.0.0-20230315185526-52ccab3ef572": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.6.1/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+	},
+	"github.com/gofiber/contrib/otelfiber@v1.0.10": {
+		"github.com/andybalholm/brotli v1.0.5/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-logr/logr v1.2.2/go.mod",
+		"github.com/go-logr/logr v1.2.4/go.mod",
+		"github.com/go-logr/stdr v1.2.2/go.mod",
+		"github.com/gofiber/fiber/v2 v2.48.0/go.mod",
+		"github.com/google/go-cmp v0.5.9",
+		"github.com/google/go-cmp v0.5.9/go.mod",
+		"github.com/google/uuid v1.3.0/go.mod",
+		"github.com/klauspost/compress v1.16.3/go.mod",
+		"github.com/mattn/go-colorable v0.1.13/go.mod",
+		"github.com/mattn/go-isatty v0.0.16/go.mod",
+		"github.com/mattn/go-isatty v0.0.19/go.mod",
+		"github.com/mattn/go-runewidth v0.0.14/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/rivo/uniseg v0.2.0/go.mod",
+		"github.com/rivo/uniseg v0.4.3/go.mod",
+		"github.com/stretchr/testify v1.8.4",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"github.com/valyala/bytebufferpool v1.0.0/go.mod",
+		"github.com/valyala/fasthttp v1.48.0/go.mod",
+		"github.com/valyala/tcplisten v1.0.0/go.mod",
+		"go.opentelemetry.io/contrib v1.17.0/go.mod",
+		"go.opentelemetry.io/contrib/propagators/b3 v1.17.0",
+		"go.opentelemetry.io/contrib/propagators/b3 v1.17.0/go.mod",
+		"go.opentelemetry.io/otel v1.16.0/go.mod",
+		"go.opentelemetry.io/otel/metric v1.16.0/go.mod",
+		"go.opentelemetry.io/otel/oteltest v1.0.0-RC3",
+		"go.opentelemetry.io/otel/oteltest v1.0.0-RC3/go.mod",
+		"go.opentelemetry.io/otel/sdk v1.16.0",
+		"go.opentelemetry.io/otel/sdk v1.16.0/go.mod",
+		"go.opentelemetry.io/otel/sdk/metric v0.39.0",
+		"go.opentelemetry.io/otel/sdk/metric v0.39.0/go.mod",
+		"go.opentelemetry.io/otel/trace v1.16.0/go.mod",
+		"golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab/go.mod",
+		"golang.org/x/sys v0.6.0/go.mod",
+		"golang.org/x/sys v0.10.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/gofiber/contrib/websocket@v1.3.0": {
+		"github.com/andybalholm/brotli v1.0.5/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/fasthttp/websocket v1.5.7/go.mod",
+		"github.com/gofiber/fiber/v2 v2.51.0/go.mod",
+		"github.com/google/uuid v1.4.0/go.mod",
+		"github.com/klauspost/compress v1.17.3/go.mod",
+		"github.com/mattn/go-colorable v0.1.13/go.mod",
+		"github.com/mattn/go-isatty v0.0.16/go.mod",
+		"github.com/mattn/go-isatty v0.0.20/go.mod",
+		"github.com/mattn/go-runewidth v0.0.15/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/rivo/uniseg v0.2.0/go.mod",
+		"github.com/savsgio/gotils v0.0.0-20230208104028-c358bd845dee/go.mod",
+		"github.com/stretchr/testify v1.8.4",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"github.com/valyala/bytebufferpool v1.0.0/go.mod",
+		"github.com/valyala/fasthttp v1.51.0/go.mod",
+		"github.com/valyala/tcplisten v1.0.0/go.mod",
+		"golang.org/x/net v0.18.0/go.mod",
+		"golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab/go.mod",
+		"golang.org/x/sys v0.6.0/go.mod",
+		"golang.org/x/sys v0.14.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/gofiber/fiber/v2@v2.52.0": {
+		"github.com/andybalholm/brotli v1.0.5/go.mod",
+		"github.com/google/uuid v1.5.0/go.mod",
+		"github.com/klauspost/compress v1.17.0/go.mod",
+		"github.com/mattn/go-colorable v0.1.13/go.mod",
+		"github.com/mattn/go-isatty v0.0.16/go.mod",
+		"github.com/mattn/go-isatty v0.0.20/go.mod",
+		"github.com/mattn/go-runewidth v0.0.15/go.mod",
+		"github.com/rivo/uniseg v0.2.0/go.mod",
+		"github.com/valyala/bytebufferpool v1.0.0/go.mod",
+		"github.com/valyala/fasthttp v1.51.0/go.mod",
+		"github.com/valyala/tcplisten v1.0.0/go.mod",
+		"golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab/go.mod",
+		"golang.org/x/sys v0.6.0/go.mod",
+		"golang.org/x/sys v0.15.0/go.mod",
+	},
+	"github.com/gogo/protobuf@v1.3.2": {
+		"github.com/kisielk/errcheck v1.5.0/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.2.1/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20201021035429-f5854403a974/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20201020160332-67f06af15bc9/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20200930185726-fdedc70b468f/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20200619180055-7c47624df98f/go.mod",
+		"golang.org/x/tools v0.0.0-20210106214847-113979e3529a/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+	},
+	"github.com/golang-jwt/jwt/v5@v5.2.0": {},
+	"github.com/golang-migrate/migrate/v4@v4.17.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/hashicorp/errwrap v1.0.0/go.mod",
+		"github.com/hashicorp/errwrap v1.1.0/go.mod",
+		"github.com/hashicorp/go-multierror v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.8.3",
+		"github.com/stretchr/testify v1.8.3/go.mod",
+		"go.uber.org/atomic v1.7.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/golang/mock@v1.4.4": {
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+	},
+	"github.com/golang/mock@v1.6.0": {
+		"github.com/yuin/goldmark v1.3.5/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/mod v0.4.2/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20210405180319-a5a99cb37ef4/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20210220032951-036812b2e83c/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210330210617-4fbd30eecc44/go.mod",
+		"golang.org/x/sys v0.0.0-20210510120138-977fb7262007/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.1.1/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+	},
+	"github.com/golang/protobuf@v1.5.2": {
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.26.0/go.mod",
+	},
+	"github.com/golang/protobuf@v1.5.3": {
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.26.0/go.mod",
+	},
+	"github.com/google/go-cmp@v0.5.2": {
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+	},
+	"github.com/google/go-cmp@v0.5.3": {
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+	},
+	"github.com/google/go-cmp@v0.5.4": {
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+	},
+	"github.com/google/go-cmp@v0.5.5": {
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+	},
+	"github.com/google/go-cmp@v0.5.6": {
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+	},
+	"github.com/google/pprof@v0.0.0-20210407192527-94a9f03dee38": {
+		"github.com/chzyer/logex v1.1.10/go.mod",
+		"github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e/go.mod",
+		"github.com/chzyer/test v0.0.0-20180213035817-a1ea475d72b1/go.mod",
+		"github.com/ianlancetaylor/demangle v0.0.0-20200824232613-28f6c0f3b639/go.mod",
+		"golang.org/x/sys v0.0.0-20191204072324-ce4227a45e2e/go.mod",
+	},
+	"github.com/google/uuid@v1.5.0": {},
+	"github.com/gorilla/mux@v1.8.1": {},
+	"github.com/gorilla/websocket@v1.5.1": {
+		"golang.org/x/net v0.17.0/go.mod",
+	},
+	"github.com/hashicorp/go-multierror@v1.1.1": {
+		"github.com/hashicorp/errwrap v1.0.0/go.mod",
+	},
+	"github.com/hashicorp/hcl@v1.0.0": {
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+	},
+	"github.com/jackc/pgpassfile@v1.0.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+	},
+	"github.com/jackc/pgservicefile@v0.0.0-20221227161230-091c0ba34f0a": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+	},
+	"github.com/jackc/pgx/v5@v5.5.1": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/jackc/pgpassfile v1.0.0/go.mod",
+		"github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a/go.mod",
+		"github.com/jackc/puddle/v2 v2.2.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"github.com/stretchr/testify v1.8.1",
+		"github.com/stretchr/testify v1.8.1/go.mod",
+		"golang.org/x/crypto v0.9.0/go.mod",
+		"golang.org/x/sync v0.1.0/go.mod",
+		"golang.org/x/text v0.9.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/jcmturner/aescts/v2@v2.0.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+	},
+	"github.com/jcmturner/dnsutils/v2@v2.0.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+	},
+	"github.com/jcmturner/gokrb5/v8@v8.4.4": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/gorilla/securecookie v1.1.1/go.mod",
+		"github.com/gorilla/sessions v1.2.1/go.mod",
+		"github.com/hashicorp/go-uuid v1.0.2/go.mod",
+		"github.com/hashicorp/go-uuid v1.0.3/go.mod",
+		"github.com/jcmturner/aescts/v2 v2.0.0/go.mod",
+		"github.com/jcmturner/dnsutils/v2 v2.0.0/go.mod",
+		"github.com/jcmturner/gofork v1.7.6/go.mod",
+		"github.com/jcmturner/goidentity/v6 v6.0.1/go.mod",
+		"github.com/jcmturner/rpc/v2 v2.0.3/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.1/go.mod",
+		"github.com/yuin/goldmark v1.4.13/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20210921155107-089bfa567519/go.mod",
+		"golang.org/x/crypto v0.6.0/go.mod",
+		"golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/net v0.0.0-20210226172049-e18ecbb05110/go.mod",
+		"golang.org/x/net v0.0.0-20220722155237-a158d28d115b/go.mod",
+		"golang.org/x/net v0.6.0/go.mod",
+		"golang.org/x/net v0.7.0/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20220722155255-886fb9371eb4/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1/go.mod",
+		"golang.org/x/sys v0.0.0-20220520151302-bc2c85ada10a/go.mod",
+		"golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f/go.mod",
+		"golang.org/x/sys v0.5.0/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/term v0.0.0-20210927222741-03fcf44c2211/go.mod",
+		"golang.org/x/term v0.5.0/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.7/go.mod",
+		"golang.org/x/text v0.7.0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.1.12/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/jcmturner/rpc/v2@v2.0.3": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+	},
+	"github.com/jmoiron/sqlx@v1.3.5": {
+		"github.com/go-sql-driver/mysql v1.6.0",
+		"github.com/go-sql-driver/mysql v1.6.0/go.mod",
+		"github.com/lib/pq v1.2.0",
+		"github.com/lib/pq v1.2.0/go.mod",
+		"github.com/mattn/go-sqlite3 v1.14.6",
+		"github.com/mattn/go-sqlite3 v1.14.6/go.mod",
+	},
+	"github.com/joho/godotenv@v1.5.1": {},
+	"github.com/json-iterator/go@v1.1.12": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/google/gofuzz v1.0.0/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180228061459-e0a39a4cb421/go.mod",
+		"github.com/modern-go/reflect2 v1.0.2/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+	},
+	"github.com/klauspost/compress@v1.12.3": {
+		"github.com/golang/snappy v0.0.3/go.mod",
+	},
+	"github.com/klauspost/cpuid/v2@v2.2.4": {
+		"golang.org/x/sys v0.0.0-20220704084225-05e143d24a9e/go.mod",
+	},
+	"github.com/kr/pretty@v0.3.0": {
+		"github.com/creack/pty v1.1.9/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/kr/text v0.2.0/go.mod",
+		"github.com/rogpeppe/go-internal v1.6.1/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/errgo.v2 v2.1.0/go.mod",
+	},
+	"github.com/kr/pretty@v0.3.1": {
+		"github.com/creack/pty v1.1.9/go.mod",
+		"github.com/kr/text v0.2.0/go.mod",
+		"github.com/pkg/diff v0.0.0-20210226163009-20ebb0f2a09e/go.mod",
+		"github.com/rogpeppe/go-internal v1.9.0/go.mod",
+	},
+	"github.com/kr/text@v0.2.0": {
+		"github.com/creack/pty v1.1.9/go.mod",
+	},
+	"github.com/labstack/echo/v4@v4.11.4": {
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/golang-jwt/jwt v3.2.2+incompatible/go.mod",
+		"github.com/labstack/gommon v0.4.2/go.mod",
+		"github.com/mattn/go-colorable v0.1.13/go.mod",
+		"github.com/mattn/go-isatty v0.0.16/go.mod",
+		"github.com/mattn/go-isatty v0.0.20/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/testify v1.8.4",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"github.com/valyala/bytebufferpool v1.0.0/go.mod",
+		"github.com/valyala/fasttemplate v1.2.2/go.mod",
+		"golang.org/x/crypto v0.17.0/go.mod",
+		"golang.org/x/net v0.19.0/go.mod",
+		"golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab/go.mod",
+		"golang.org/x/sys v0.6.0/go.mod",
+		"golang.org/x/sys v0.15.0/go.mod",
+		"golang.org/x/text v0.14.0/go.mod",
+		"golang.org/x/time v0.5.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/leodido/go-urn@v1.2.4": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.2/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/linkedin/goavro/v2@v2.9.8": {
+		"github.com/golang/snappy v0.0.1/go.mod",
+	},
+	"github.com/lufia/plan9stats@v0.0.0-20211012122336-39d0f177ccd0": {
+		"github.com/google/go-cmp v0.5.6/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+	},
+	"github.com/mailru/easyjson@v0.7.6": {
+		"github.com/josharian/intern v1.0.0/go.mod",
+	},
+	"github.com/mattn/go-colorable@v0.1.13": {
+		"github.com/mattn/go-isatty v0.0.16/go.mod",
+		"golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab/go.mod",
+	},
+	"github.com/mattn/go-isatty@v0.0.18": {
+		"golang.org/x/sys v0.6.0/go.mod",
+	},
+	"github.com/mattn/go-isatty@v0.0.19": {
+		"golang.org/x/sys v0.6.0/go.mod",
+	},
+	"github.com/mattn/go-isatty@v0.0.20": {
+		"golang.org/x/sys v0.6.0/go.mod",
+	},
+	"github.com/mattn/go-runewidth@v0.0.14": {
+		"github.com/rivo/uniseg v0.2.0/go.mod",
+	},
+	"github.com/mattn/go-runewidth@v0.0.15": {
+		"github.com/rivo/uniseg v0.2.0/go.mod",
+	},
+	"github.com/mattn/go-sqlite3@v1.14.19": {},
+	"github.com/matttproud/golang_protobuf_extensions@v1.0.4": {
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+	},
+	"github.com/muesli/reflow@v0.3.0": {
+		"github.com/mattn/go-runewidth v0.0.12/go.mod",
+		"github.com/rivo/uniseg v0.1.0/go.mod",
+		"github.com/rivo/uniseg v0.2.0/go.mod",
+	},
+	"github.com/nats-io/nats.go@v1.31.0": {
+		"github.com/klauspost/compress v1.17.0/go.mod",
+		"github.com/nats-io/nkeys v0.4.5/go.mod",
+		"github.com/nats-io/nuid v1.0.1/go.mod",
+		"golang.org/x/crypto v0.6.0/go.mod",
+		"golang.org/x/sys v0.5.0/go.mod",
+	},
+	"github.com/nxadm/tail@v1.4.8": {
+		"github.com/fsnotify/fsnotify v1.4.9/go.mod",
+		"golang.org/x/sys v0.0.0-20191005200804-aed5e4c7ecf9/go.mod",
+		"gopkg.in/tomb.v1 v1.0.0-20141024135613-dd632973f1e7/go.mod",
+	},
+	"github.com/onsi/ginkgo@v1.16.5": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/fsnotify/fsnotify v1.4.7/go.mod",
+		"github.com/fsnotify/fsnotify v1.4.9/go.mod",
+		"github.com/go-task/slim-sprig v0.0.0-20210107165309-348f09dbbbc0/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/hpcloud/tail v1.0.0/go.mod",
+		"github.com/nxadm/tail v1.4.4/go.mod",
+		"github.com/nxadm/tail v1.4.8/go.mod",
+		"github.com/onsi/ginkgo v1.6.0/go.mod",
+		"github.com/onsi/ginkgo v1.12.1/go.mod",
+		"github.com/onsi/gomega v1.7.1/go.mod",
+		"github.com/onsi/gomega v1.10.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.5.1/go.mod",
+		"github.com/yuin/goldmark v1.2.1/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/net v0.0.0-20180906233101-161cd47e91fd/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20200520004742-59133d7f0dd7/go.mod",
+		"golang.org/x/net v0.0.0-20201021035429-f5854403a974/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20201020160332-67f06af15bc9/go.mod",
+		"golang.org/x/sys v0.0.0-20180909124046-d0be0721c37e/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190904154756-749cb33beabd/go.mod",
+		"golang.org/x/sys v0.0.0-20191005200804-aed5e4c7ecf9/go.mod",
+		"golang.org/x/sys v0.0.0-20191120155948-bd437916bb0e/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200930185726-fdedc70b468f/go.mod",
+		"golang.org/x/sys v0.0.0-20210112080510-489259a85091/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20201224043029-2b0845dc783e/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/fsnotify.v1 v1.4.7/go.mod",
+		"gopkg.in/tomb.v1 v1.0.0-20141024135613-dd632973f1e7/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.2.4/go.mod",
+		"gopkg.in/yaml.v2 v2.3.0/go.mod",
+	},
+	"github.com/onsi/ginkgo/v2@v2.15.0": {
+		"github.com/chzyer/logex v1.1.10/go.mod",
+		"github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e/go.mod",
+		"github.com/chzyer/test v0.0.0-20180213035817-a1ea475d72b1/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-logr/logr v1.3.0/go.mod",
+		"github.com/go-task/slim-sprig v0.0.0-20230315185526-52ccab3ef572/go.mod",
+		"github.com/golang/protobuf v1.5.3",
+		"github.com/golang/protobuf v1.5.3/go.mod",
+		"github.com/google/go-cmp v0.6.0",
+		"github.com/google/go-cmp v0.6.0/go.mod",
+		"github.com/google/pprof v0.0.0-20210407192527-94a9f03dee38/go.mod",
+		"github.com/ianlancetaylor/demangle v0.0.0-20200824232613-28f6c0f3b639/go.mod",
+		"github.com/onsi/gomega v1.30.0",
+		"github.com/onsi/gomega v1.30.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.6.1",
+		"github.com/stretchr/testify v1.6.1/go.mod",
+		"golang.org/x/net v0.19.0/go.mod",
+		"golang.org/x/sys v0.0.0-20191204072324-ce4227a45e2e/go.mod",
+		"golang.org/x/sys v0.15.0/go.mod",
+		"golang.org/x/text v0.14.0",
+		"golang.org/x/text v0.14.0/go.mod",
+		"golang.org/x/tools v0.16.1/go.mod",
+		"google.golang.org/protobuf v1.28.0",
+		"google.golang.org/protobuf v1.28.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/opentracing/opentracing-go@v1.2.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+	},
+	"github.com/pelletier/go-toml/v2@v2.0.8": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.3/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/pelletier/go-toml/v2@v2.1.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/power-devops/perfstat@v0.0.0-20210106213030-5aafc221ea8c": {
+		"golang.org/x/sys v0.0.0-20201204225414-ed752295db88/go.mod",
+	},
+	"github.com/prometheus/client_golang@v1.11.1": {
+		"cloud.google.com/go v0.34.0/go.mod",
+		"github.com/alecthomas/template v0.0.0-20160405071501-a0175ee3bccc/go.mod",
+		"github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751/go.mod",
+		"github.com/alecthomas/units v0.0.0-20151022065526-2efee857e7cf/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190717042225-c3de453c63f4/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190924025748-f65c72e2690d/go.mod",
+		"github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973/go.mod",
+		"github.com/beorn7/perks v1.0.0/go.mod",
+		"github.com/beorn7/perks v1.0.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.1.1/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-kit/kit v0.8.0/go.mod",
+		"github.com/go-kit/kit v0.9.0/go.mod",
+		"github.com/go-kit/log v0.1.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.3.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.4.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.5.0/go.mod",
+		"github.com/go-stack/stack v1.8.0/go.mod",
+		"github.com/gogo/protobuf v1.1.1/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/golang/protobuf v1.4.3/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.5.4/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/google/gofuzz v1.0.0/go.mod",
+		"github.com/jpillora/backoff v1.0.0/go.mod",
+		"github.com/json-iterator/go v1.1.6/go.mod",
+		"github.com/json-iterator/go v1.1.10/go.mod",
+		"github.com/json-iterator/go v1.1.11/go.mod",
+		"github.com/julienschmidt/httprouter v1.2.0/go.mod",
+		"github.com/julienschmidt/httprouter v1.3.0/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.1/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.3/go.mod",
+		"github.com/kr/logfmt v0.0.0-20140226030751-b84e30acd515/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/matttproud/golang_protobuf_extensions v1.0.1/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180228061459-e0a39a4cb421/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd/go.mod",
+		"github.com/modern-go/reflect2 v0.0.0-20180701023420-4b7aa43c6742/go.mod",
+		"github.com/modern-go/reflect2 v1.0.1/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20161129095857-cc309e4a2223/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20190716064945-2f068394615f/go.mod",
+		"github.com/pkg/errors v0.8.0/go.mod",
+		"github.com/pkg/errors v0.8.1/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v0.9.1/go.mod",
+		"github.com/prometheus/client_golang v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v1.7.1/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20180712105110-5c3871d89910/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190129233127-fd36f4220a90/go.mod",
+		"github.com/prometheus/client_model v0.2.0/go.mod",
+		"github.com/prometheus/common v0.4.1/go.mod",
+		"github.com/prometheus/common v0.10.0/go.mod",
+		"github.com/prometheus/common v0.26.0/go.mod",
+		"github.com/prometheus/procfs v0.0.0-20181005140218-185b4288413d/go.mod",
+		"github.com/prometheus/procfs v0.0.2/go.mod",
+		"github.com/prometheus/procfs v0.1.3/go.mod",
+		"github.com/prometheus/procfs v0.6.0/go.mod",
+		"github.com/sirupsen/logrus v1.2.0/go.mod",
+		"github.com/sirupsen/logrus v1.4.2/go.mod",
+		"github.com/sirupsen/logrus v1.6.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.1.1/go.mod",
+		"github.com/stretchr/testify v1.2.2/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"golang.org/x/crypto v0.0.0-20180904163835-0709b304e793/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20181114220301-adae6a3d119a/go.mod",
+		"golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190613194153-d28f0bde5980/go.mod",
+		"golang.org/x/net v0.0.0-20200625001655-4c5254603344/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190226205417-e64efc72b421/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20201207232520-09787c993a3a/go.mod",
+		"golang.org/x/sys v0.0.0-20180905080454-ebe1bf3edb33/go.mod",
+		"golang.org/x/sys v0.0.0-20181116152217-5ac8a444bdc5/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190422165155-953cdadca894/go.mod",
+		"golang.org/x/sys v0.0.0-20200106162015-b016eb3dc98e/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200615200032-f1bc736245b1/go.mod",
+		"golang.org/x/sys v0.0.0-20200625212154-ddb9806d33ae/go.mod",
+		"golang.org/x/sys v0.0.0-20210124154548-22da62e12c0c/go.mod",
+		"golang.org/x/sys v0.0.0-20210603081109-ebe580a85c40/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.2/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"gopkg.in/alecthomas/kingpin.v2 v2.2.6/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15/go.mod",
+		"gopkg.in/yaml.v2 v2.2.1/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.2.4/go.mod",
+		"gopkg.in/yaml.v2 v2.2.5/go.mod",
+		"gopkg.in/yaml.v2 v2.3.0/go.mod",
+	},
+	"github.com/prometheus/client_golang@v1.12.1": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"cloud.google.com/go v0.34.0/go.mod",
+		"cloud.google.com/go v0.38.0/go.mod",
+		"cloud.google.com/go v0.44.1/go.mod",
+		"cloud.google.com/go v0.44.2/go.mod",
+		"cloud.google.com/go v0.45.1/go.mod",
+		"cloud.google.com/go v0.46.3/go.mod",
+		"cloud.google.com/go v0.50.0/go.mod",
+		"cloud.google.com/go v0.52.0/go.mod",
+		"cloud.google.com/go v0.53.0/go.mod",
+		"cloud.google.com/go v0.54.0/go.mod",
+		"cloud.google.com/go v0.56.0/go.mod",
+		"cloud.google.com/go v0.57.0/go.mod",
+		"cloud.google.com/go v0.62.0/go.mod",
+		"cloud.google.com/go v0.65.0/go.mod",
+		"cloud.google.com/go/bigquery v1.0.1/go.mod",
+		"cloud.google.com/go/bigquery v1.3.0/go.mod",
+		"cloud.google.com/go/bigquery v1.4.0/go.mod",
+		"cloud.google.com/go/bigquery v1.5.0/go.mod",
+		"cloud.google.com/go/bigquery v1.7.0/go.mod",
+		"cloud.google.com/go/bigquery v1.8.0/go.mod",
+		"cloud.google.com/go/datastore v1.0.0/go.mod",
+		"cloud.google.com/go/datastore v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.0.1/go.mod",
+		"cloud.google.com/go/pubsub v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.2.0/go.mod",
+		"cloud.google.com/go/pubsub v1.3.1/go.mod",
+		"cloud.google.com/go/storage v1.0.0/go.mod",
+		"cloud.google.com/go/storage v1.5.0/go.mod",
+		"cloud.google.com/go/storage v1.6.0/go.mod",
+		"cloud.google.com/go/storage v1.8.0/go.mod",
+		"cloud.google.com/go/storage v1.10.0/go.mod",
+		"dmitri.shuralyov.com/gpu/mtl v0.0.0-20190408044501-666a987793e9/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/BurntSushi/xgb v0.0.0-20160522181843-27f122750802/go.mod",
+		"github.com/alecthomas/template v0.0.0-20160405071501-a0175ee3bccc/go.mod",
+		"github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751/go.mod",
+		"github.com/alecthomas/units v0.0.0-20151022065526-2efee857e7cf/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190717042225-c3de453c63f4/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190924025748-f65c72e2690d/go.mod",
+		"github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973/go.mod",
+		"github.com/beorn7/perks v1.0.0/go.mod",
+		"github.com/beorn7/perks v1.0.1/go.mod",
+		"github.com/census-instrumentation/opencensus-proto v0.2.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.1.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.1.2/go.mod",
+		"github.com/chzyer/logex v1.1.10/go.mod",
+		"github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e/go.mod",
+		"github.com/chzyer/test v0.0.0-20180213035817-a1ea475d72b1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/cncf/udpa/go v0.0.0-20191209042840-269d4d468f6f/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.1-0.20191026205805-5f8ba28d4473/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.4/go.mod",
+		"github.com/envoyproxy/protoc-gen-validate v0.1.0/go.mod",
+		"github.com/go-gl/glfw v0.0.0-20190409004039-e6da0acd62b1/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20191125211704-12ad95a8df72/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20200222043503-6f7a984d4dc4/go.mod",
+		"github.com/go-kit/kit v0.8.0/go.mod",
+		"github.com/go-kit/kit v0.9.0/go.mod",
+		"github.com/go-kit/log v0.1.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.3.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.4.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.5.0/go.mod",
+		"github.com/go-stack/stack v1.8.0/go.mod",
+		"github.com/gogo/protobuf v1.1.1/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6/go.mod",
+		"github.com/golang/groupcache v0.0.0-20191227052852-215e87163ea7/go.mod",
+		"github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/mock v1.2.0/go.mod",
+		"github.com/golang/mock v1.3.1/go.mod",
+		"github.com/golang/mock v1.4.0/go.mod",
+		"github.com/golang/mock v1.4.1/go.mod",
+		"github.com/golang/mock v1.4.3/go.mod",
+		"github.com/golang/mock v1.4.4/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.3.3/go.mod",
+		"github.com/golang/protobuf v1.3.4/go.mod",
+		"github.com/golang/protobuf v1.3.5/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.1/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/golang/protobuf v1.4.3/go.mod",
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/golang/protobuf v1.5.2/go.mod",
+		"github.com/google/btree v0.0.0-20180813153112-4030bb1f1f0c/go.mod",
+		"github.com/google/btree v1.0.0/go.mod",
+		"github.com/google/go-cmp v0.2.0/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.4.1/go.mod",
+		"github.com/google/go-cmp v0.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.1/go.mod",
+		"github.com/google/go-cmp v0.5.4/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/google/gofuzz v1.0.0/go.mod",
+		"github.com/google/martian v2.1.0+incompatible/go.mod",
+		"github.com/google/martian/v3 v3.0.0/go.mod",
+		"github.com/google/pprof v0.0.0-20181206194817-3ea8567a2e57/go.mod",
+		"github.com/google/pprof v0.0.0-20190515194954-54271f7e092f/go.mod",
+		"github.com/google/pprof v0.0.0-20191218002539-d4f498aebedc/go.mod",
+		"github.com/google/pprof v0.0.0-20200212024743-f11f1df84d12/go.mod",
+		"github.com/google/pprof v0.0.0-20200229191704-1ebb73c60ed3/go.mod",
+		"github.com/google/pprof v0.0.0-20200430221834-fc25d7d30c6d/go.mod",
+		"github.com/google/pprof v0.0.0-20200708004538-1a94d8640e99/go.mod",
+		"github.com/google/renameio v0.1.0/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.4/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.5/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.0/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.1/go.mod",
+		"github.com/ianlancetaylor/demangle v0.0.0-20181102032728-5e5cf60278f6/go.mod",
+		"github.com/jpillora/backoff v1.0.0/go.mod",
+		"github.com/json-iterator/go v1.1.6/go.mod",
+		"github.com/json-iterator/go v1.1.10/go.mod",
+		"github.com/json-iterator/go v1.1.11/go.mod",
+		"github.com/json-iterator/go v1.1.12/go.mod",
+		"github.com/jstemmer/go-junit-report v0.0.0-20190106144839-af01ea7f8024/go.mod",
+		"github.com/jstemmer/go-junit-report v0.9.1/go.mod",
+		"github.com/julienschmidt/httprouter v1.2.0/go.mod",
+		"github.com/julienschmidt/httprouter v1.3.0/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.1/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.3/go.mod",
+		"github.com/kr/logfmt v0.0.0-20140226030751-b84e30acd515/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/matttproud/golang_protobuf_extensions v1.0.1/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180228061459-e0a39a4cb421/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd/go.mod",
+		"github.com/modern-go/reflect2 v0.0.0-20180701023420-4b7aa43c6742/go.mod",
+		"github.com/modern-go/reflect2 v1.0.1/go.mod",
+		"github.com/modern-go/reflect2 v1.0.2/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20161129095857-cc309e4a2223/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20190716064945-2f068394615f/go.mod",
+		"github.com/pkg/errors v0.8.0/go.mod",
+		"github.com/pkg/errors v0.8.1/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v0.9.1/go.mod",
+		"github.com/prometheus/client_golang v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v1.7.1/go.mod",
+		"github.com/prometheus/client_golang v1.11.0/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20180712105110-5c3871d89910/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190129233127-fd36f4220a90/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4/go.mod",
+		"github.com/prometheus/client_model v0.2.0/go.mod",
+		"github.com/prometheus/common v0.4.1/go.mod",
+		"github.com/prometheus/common v0.10.0/go.mod",
+		"github.com/prometheus/common v0.26.0/go.mod",
+		"github.com/prometheus/common v0.32.1/go.mod",
+		"github.com/prometheus/procfs v0.0.0-20181005140218-185b4288413d/go.mod",
+		"github.com/prometheus/procfs v0.0.2/go.mod",
+		"github.com/prometheus/procfs v0.1.3/go.mod",
+		"github.com/prometheus/procfs v0.6.0/go.mod",
+		"github.com/prometheus/procfs v0.7.3/go.mod",
+		"github.com/rogpeppe/go-internal v1.3.0/go.mod",
+		"github.com/sirupsen/logrus v1.2.0/go.mod",
+		"github.com/sirupsen/logrus v1.4.2/go.mod",
+		"github.com/sirupsen/logrus v1.6.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.1.1/go.mod",
+		"github.com/stretchr/testify v1.2.2/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/yuin/goldmark v1.1.25/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.1.32/go.mod",
+		"go.opencensus.io v0.21.0/go.mod",
+		"go.opencensus.io v0.22.0/go.mod",
+		"go.opencensus.io v0.22.2/go.mod",
+		"go.opencensus.io v0.22.3/go.mod",
+		"go.opencensus.io v0.22.4/go.mod",
+		"golang.org/x/crypto v0.0.0-20180904163835-0709b304e793/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20190510104115-cbcb75029529/go.mod",
+		"golang.org/x/crypto v0.0.0-20190605123033-f99c8df09eb5/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/exp v0.0.0-20190306152737-a1d7652674e8/go.mod",
+		"golang.org/x/exp v0.0.0-20190510132918-efd6b22b2522/go.mod",
+		"golang.org/x/exp v0.0.0-20190829153037-c13cbed26979/go.mod",
+		"golang.org/x/exp v0.0.0-20191030013958-a1ab85dbe136/go.mod",
+		"golang.org/x/exp v0.0.0-20191129062945-2f5052295587/go.mod",
+		"golang.org/x/exp v0.0.0-20191227195350-da58074b4299/go.mod",
+		"golang.org/x/exp v0.0.0-20200119233911-0405dc783f0a/go.mod",
+		"golang.org/x/exp v0.0.0-20200207192155-f17229e696bd/go.mod",
+		"golang.org/x/exp v0.0.0-20200224162631-6cc2880d07d6/go.mod",
+		"golang.org/x/image v0.0.0-20190227222117-0694c2d4d067/go.mod",
+		"golang.org/x/image v0.0.0-20190802002840-cff245a6509b/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190301231843-5614ed5bae6f/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/lint v0.0.0-20190409202823-959b441ac422/go.mod",
+		"golang.org/x/lint v0.0.0-20190909230951-414d861bb4ac/go.mod",
+		"golang.org/x/lint v0.0.0-20190930215403-16217165b5de/go.mod",
+		"golang.org/x/lint v0.0.0-20191125180803-fdd1cda4f05f/go.mod",
+		"golang.org/x/lint v0.0.0-20200130185559-910be7a94367/go.mod",
+		"golang.org/x/lint v0.0.0-20200302205851-738671d3881b/go.mod",
+		"golang.org/x/mobile v0.0.0-20190312151609-d3739f865fa6/go.mod",
+		"golang.org/x/mobile v0.0.0-20190719004257-d2bd2a29d028/go.mod",
+		"golang.org/x/mod v0.0.0-20190513183733-4bf6d317e70e/go.mod",
+		"golang.org/x/mod v0.1.0/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191105210325-c90efee705ee/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191107180719-034126e5016b/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20181114220301-adae6a3d119a/go.mod",
+		"golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190501004415-9ce7a6920f09/go.mod",
+		"golang.org/x/net v0.0.0-20190503192946-f4e77d36d62c/go.mod",
+		"golang.org/x/net v0.0.0-20190603091049-60506f45cf65/go.mod",
+		"golang.org/x/net v0.0.0-20190613194153-d28f0bde5980/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20190628185345-da137c7871d7/go.mod",
+		"golang.org/x/net v0.0.0-20190724013045-ca1201d0de80/go.mod",
+		"golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/net v0.0.0-20200202094626-16171245cfb2/go.mod",
+		"golang.org/x/net v0.0.0-20200222125558-5a598a2470a0/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20200301022130-244492dfa37a/go.mod",
+		"golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e/go.mod",
+		"golang.org/x/net v0.0.0-20200501053045-e0ff5e5a1de5/go.mod",
+		"golang.org/x/net v0.0.0-20200506145744-7e3656a0809f/go.mod",
+		"golang.org/x/net v0.0.0-20200513185701-a91f0712d120/go.mod",
+		"golang.org/x/net v0.0.0-20200520182314-0ba52f642ac2/go.mod",
+		"golang.org/x/net v0.0.0-20200625001655-4c5254603344/go.mod",
+		"golang.org/x/net v0.0.0-20200707034311-ab3426394381/go.mod",
+		"golang.org/x/net v0.0.0-20200822124328-c89045814202/go.mod",
+		"golang.org/x/net v0.0.0-20210525063256-abc453219eb5/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190226205417-e64efc72b421/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20191202225959-858c2ad4c8b6/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20210514164344-f6687ab2804c/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+		"golang.org/x/sync v0.0.0-20190227155943-e225da77a7e6/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20200317015054-43a5402ce75a/go.mod",
+		"golang.org/x/sync v0.0.0-20200625203802-6e8e738ad208/go.mod",
+		"golang.org/x/sync v0.0.0-20201207232520-09787c993a3a/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20180905080454-ebe1bf3edb33/go.mod",
+		"golang.org/x/sys v0.0.0-20181116152217-5ac8a444bdc5/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190312061237-fead79001313/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190422165155-953cdadca894/go.mod",
+		"golang.org/x/sys v0.0.0-20190502145724-3ef323f4f1fd/go.mod",
+		"golang.org/x/sys v0.0.0-20190507160741-ecd444e8653b/go.mod",
+		"golang.org/x/sys v0.0.0-20190606165138-5da285871e9c/go.mod",
+		"golang.org/x/sys v0.0.0-20190624142023-c5567b49c5d0/go.mod",
+		"golang.org/x/sys v0.0.0-20190726091711-fc99dfbffb4e/go.mod",
+		"golang.org/x/sys v0.0.0-20191001151750-bb3f8db39f24/go.mod",
+		"golang.org/x/sys v0.0.0-20191204072324-ce4227a45e2e/go.mod",
+		"golang.org/x/sys v0.0.0-20191228213918-04cbcbbfeed8/go.mod",
+		"golang.org/x/sys v0.0.0-20200106162015-b016eb3dc98e/go.mod",
+		"golang.org/x/sys v0.0.0-20200113162924-86b910548bc1/go.mod",
+		"golang.org/x/sys v0.0.0-20200122134326-e047566fdf82/go.mod",
+		"golang.org/x/sys v0.0.0-20200202164722-d101bd2416d5/go.mod",
+		"golang.org/x/sys v0.0.0-20200212091648-12a6c2dcc1e4/go.mod",
+		"golang.org/x/sys v0.0.0-20200223170610-d5e6a3e2c0ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200302150141-5c8b2ff67527/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200331124033-c3d80250170d/go.mod",
+		"golang.org/x/sys v0.0.0-20200501052902-10377860bb8e/go.mod",
+		"golang.org/x/sys v0.0.0-20200511232937-7e40ca221e25/go.mod",
+		"golang.org/x/sys v0.0.0-20200515095857-1151b9dac4a9/go.mod",
+		"golang.org/x/sys v0.0.0-20200523222454-059865788121/go.mod",
+		"golang.org/x/sys v0.0.0-20200615200032-f1bc736245b1/go.mod",
+		"golang.org/x/sys v0.0.0-20200625212154-ddb9806d33ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200803210538-64077c9b5642/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210124154548-22da62e12c0c/go.mod",
+		"golang.org/x/sys v0.0.0-20210423082822-04245dca01da/go.mod",
+		"golang.org/x/sys v0.0.0-20210603081109-ebe580a85c40/go.mod",
+		"golang.org/x/sys v0.0.0-20220114195835-da31bd327af9/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/text v0.0.0-20170915032832-14c0d48ead0c/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.1-0.20180807135948-17ff2d5776d2/go.mod",
+		"golang.org/x/text v0.3.2/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.6/go.mod",
+		"golang.org/x/time v0.0.0-20181108054448-85acf8d2951c/go.mod",
+		"golang.org/x/time v0.0.0-20190308202827-9d24e82272b4/go.mod",
+		"golang.org/x/time v0.0.0-20191024005414-555d28b269f0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20190312151545-0bb0c0a6e846/go.mod",
+		"golang.org/x/tools v0.0.0-20190312170243-e65039ee4138/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+		"golang.org/x/tools v0.0.0-20190506145303-2d16b83fe98c/go.mod",
+		"golang.org/x/tools v0.0.0-20190524140312-2c0ae7006135/go.mod",
+		"golang.org/x/tools v0.0.0-20190606124116-d0a3d012864b/go.mod",
+		"golang.org/x/tools v0.0.0-20190621195816-6e04913cbbac/go.mod",
+		"golang.org/x/tools v0.0.0-20190628153133-6cdbf07be9d0/go.mod",
+		"golang.org/x/tools v0.0.0-20190816200558-6889da9d5479/go.mod",
+		"golang.org/x/tools v0.0.0-20190911174233-4f2ddba30aff/go.mod",
+		"golang.org/x/tools v0.0.0-20191012152004-8de300cfc20a/go.mod",
+		"golang.org/x/tools v0.0.0-20191113191852-77e3bb0ad9e7/go.mod",
+		"golang.org/x/tools v0.0.0-20191115202509-3a792d9c32b2/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20191125144606-a911d9008d1f/go.mod",
+		"golang.org/x/tools v0.0.0-20191130070609-6e064ea0cf2d/go.mod",
+		"golang.org/x/tools v0.0.0-20191216173652-a0e659d51361/go.mod",
+		"golang.org/x/tools v0.0.0-20191227053925-7b8e75db28f4/go.mod",
+		"golang.org/x/tools v0.0.0-20200117161641-43d50277825c/go.mod",
+		"golang.org/x/tools v0.0.0-20200122220014-bf1340f18c4a/go.mod",
+		"golang.org/x/tools v0.0.0-20200130002326-2f3ba24bd6e7/go.mod",
+		"golang.org/x/tools v0.0.0-20200204074204-1cc6d1ef6c74/go.mod",
+		"golang.org/x/tools v0.0.0-20200207183749-b753a1ba74fa/go.mod",
+		"golang.org/x/tools v0.0.0-20200212150539-ea181f53ac56/go.mod",
+		"golang.org/x/tools v0.0.0-20200224181240-023911ca70b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200227222343-706bc42d1f0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200304193943-95d2e580d8eb/go.mod",
+		"golang.org/x/tools v0.0.0-20200312045724-11d5b4c81c7d/go.mod",
+		"golang.org/x/tools v0.0.0-20200331025713-a30bf2db82d4/go.mod",
+		"golang.org/x/tools v0.0.0-20200501065659-ab2804fb9c9d/go.mod",
+		"golang.org/x/tools v0.0.0-20200512131952-2bc93b1c0c88/go.mod",
+		"golang.org/x/tools v0.0.0-20200515010526-7d3b6ebf133d/go.mod",
+		"golang.org/x/tools v0.0.0-20200618134242-20370b0cb4b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200729194436-6467de6f59a7/go.mod",
+		"golang.org/x/tools v0.0.0-20200804011535-6c149bb5ef0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200825202427-b303f430e36d/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/api v0.4.0/go.mod",
+		"google.golang.org/api v0.7.0/go.mod",
+		"google.golang.org/api v0.8.0/go.mod",
+		"google.golang.org/api v0.9.0/go.mod",
+		"google.golang.org/api v0.13.0/go.mod",
+		"google.golang.org/api v0.14.0/go.mod",
+		"google.golang.org/api v0.15.0/go.mod",
+		"google.golang.org/api v0.17.0/go.mod",
+		"google.golang.org/api v0.18.0/go.mod",
+		"google.golang.org/api v0.19.0/go.mod",
+		"google.golang.org/api v0.20.0/go.mod",
+		"google.golang.org/api v0.22.0/go.mod",
+		"google.golang.org/api v0.24.0/go.mod",
+		"google.golang.org/api v0.28.0/go.mod",
+		"google.golang.org/api v0.29.0/go.mod",
+		"google.golang.org/api v0.30.0/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/appengine v1.5.0/go.mod",
+		"google.golang.org/appengine v1.6.1/go.mod",
+		"google.golang.org/appengine v1.6.5/go.mod",
+		"google.golang.org/appengine v1.6.6/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190307195333-5fe7a883aa19/go.mod",
+		"google.golang.org/genproto v0.0.0-20190418145605-e7d98fc518a7/go.mod",
+		"google.golang.org/genproto v0.0.0-20190425155659-357c62f0e4bb/go.mod",
+		"google.golang.org/genproto v0.0.0-20190502173448-54afdca5d873/go.mod",
+		"google.golang.org/genproto v0.0.0-20190801165951-fa694d86fc64/go.mod",
+		"google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55/go.mod",
+		"google.golang.org/genproto v0.0.0-20190911173649-1774047e7e51/go.mod",
+		"google.golang.org/genproto v0.0.0-20191108220845-16a3f7862a1a/go.mod",
+		"google.golang.org/genproto v0.0.0-20191115194625-c23dd37a84c9/go.mod",
+		"google.golang.org/genproto v0.0.0-20191216164720-4f79533eabd1/go.mod",
+		"google.golang.org/genproto v0.0.0-20191230161307-f3c370f40bfb/go.mod",
+		"google.golang.org/genproto v0.0.0-20200115191322-ca5a22157cba/go.mod",
+		"google.golang.org/genproto v0.0.0-20200122232147-0452cf42e150/go.mod",
+		"google.golang.org/genproto v0.0.0-20200204135345-fa8e72b47b90/go.mod",
+		"google.golang.org/genproto v0.0.0-20200212174721-66ed5ce911ce/go.mod",
+		"google.golang.org/genproto v0.0.0-20200224152610-e50cd9704f63/go.mod",
+		"google.golang.org/genproto v0.0.0-20200228133532-8c2c7df3a383/go.mod",
+		"google.golang.org/genproto v0.0.0-20200305110556-506484158171/go.mod",
+		"google.golang.org/genproto v0.0.0-20200312145019-da6875a35672/go.mod",
+		"google.golang.org/genproto v0.0.0-20200331122359-1ee6d9798940/go.mod",
+		"google.golang.org/genproto v0.0.0-20200430143042-b979b6f78d84/go.mod",
+		"google.golang.org/genproto v0.0.0-20200511104702-f5ebc3bea380/go.mod",
+		"google.golang.org/genproto v0.0.0-20200515170657-fc4c6c6a6587/go.mod",
+		"google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013/go.mod",
+		"google.golang.org/genproto v0.0.0-20200618031413-b414f8b61790/go.mod",
+		"google.golang.org/genproto v0.0.0-20200729003335-053ba62fc06f/go.mod",
+		"google.golang.org/genproto v0.0.0-20200804131852-c06518451d9c/go.mod",
+		"google.golang.org/genproto v0.0.0-20200825200019-8632dd797987/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.20.1/go.mod",
+		"google.golang.org/grpc v1.21.1/go.mod",
+		"google.golang.org/grpc v1.23.0/go.mod",
+		"google.golang.org/grpc v1.25.1/go.mod",
+		"google.golang.org/grpc v1.26.0/go.mod",
+		"google.golang.org/grpc v1.27.0/go.mod",
+		"google.golang.org/grpc v1.27.1/go.mod",
+		"google.golang.org/grpc v1.28.0/go.mod",
+		"google.golang.org/grpc v1.29.1/go.mod",
+		"google.golang.org/grpc v1.30.0/go.mod",
+		"google.golang.org/grpc v1.31.0/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.22.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.23.1-0.20200526195155-81db48ad09cc/go.mod",
+		"google.golang.org/protobuf v1.24.0/go.mod",
+		"google.golang.org/protobuf v1.25.0/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.26.0/go.mod",
+		"gopkg.in/alecthomas/kingpin.v2 v2.2.6/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15/go.mod",
+		"gopkg.in/errgo.v2 v2.1.0/go.mod",
+		"gopkg.in/yaml.v2 v2.2.1/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.2.4/go.mod",
+		"gopkg.in/yaml.v2 v2.2.5/go.mod",
+		"gopkg.in/yaml.v2 v2.3.0/go.mod",
+		"gopkg.in/yaml.v2 v2.4.0/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+		"honnef.co/go/tools v0.0.0-20190106161140-3f1c8253044a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190418001031-e561f6794a2a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190523083050-ea95bdfd59fc/go.mod",
+		"honnef.co/go/tools v0.0.1-2019.2.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.4/go.mod",
+		"rsc.io/binaryregexp v0.2.0/go.mod",
+		"rsc.io/quote/v3 v3.1.0/go.mod",
+		"rsc.io/sampler v1.3.0/go.mod",
+	},
+	"github.com/prometheus/client_golang@v1.18.0": {
+		"github.com/beorn7/perks v1.0.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.2.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/google/go-cmp v0.5.9",
+		"github.com/google/go-cmp v0.5.9/go.mod",
+		"github.com/matttproud/golang_protobuf_extensions/v2 v2.0.0/go.mod",
+		"github.com/prometheus/client_model v0.5.0/go.mod",
+		"github.com/prometheus/common v0.45.0/go.mod",
+		"github.com/prometheus/procfs v0.12.0/go.mod",
+		"golang.org/x/sys v0.15.0/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.31.0/go.mod",
+	},
+	"github.com/prometheus/client_model@v0.2.0": {
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+	},
+	"github.com/prometheus/client_model@v0.3.0": {
+		"github.com/golang/protobuf v1.3.5/go.mod",
+	},
+	"github.com/prometheus/common@v0.26.0": {
+		"cloud.google.com/go v0.34.0/go.mod",
+		"github.com/alecthomas/template v0.0.0-20160405071501-a0175ee3bccc/go.mod",
+		"github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751/go.mod",
+		"github.com/alecthomas/units v0.0.0-20151022065526-2efee857e7cf/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190717042225-c3de453c63f4/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190924025748-f65c72e2690d/go.mod",
+		"github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973/go.mod",
+		"github.com/beorn7/perks v1.0.0/go.mod",
+		"github.com/beorn7/perks v1.0.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.1.1/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-kit/kit v0.8.0/go.mod",
+		"github.com/go-kit/kit v0.9.0/go.mod",
+		"github.com/go-kit/log v0.1.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.3.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.4.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.5.0/go.mod",
+		"github.com/go-stack/stack v1.8.0/go.mod",
+		"github.com/gogo/protobuf v1.1.1/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/gofuzz v1.0.0/go.mod",
+		"github.com/jpillora/backoff v1.0.0/go.mod",
+		"github.com/json-iterator/go v1.1.6/go.mod",
+		"github.com/json-iterator/go v1.1.10/go.mod",
+		"github.com/julienschmidt/httprouter v1.2.0/go.mod",
+		"github.com/julienschmidt/httprouter v1.3.0/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.1/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.3/go.mod",
+		"github.com/kr/logfmt v0.0.0-20140226030751-b84e30acd515/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/matttproud/golang_protobuf_extensions v1.0.1/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180228061459-e0a39a4cb421/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd/go.mod",
+		"github.com/modern-go/reflect2 v0.0.0-20180701023420-4b7aa43c6742/go.mod",
+		"github.com/modern-go/reflect2 v1.0.1/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20161129095857-cc309e4a2223/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20190716064945-2f068394615f/go.mod",
+		"github.com/pkg/errors v0.8.0/go.mod",
+		"github.com/pkg/errors v0.8.1/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v0.9.1/go.mod",
+		"github.com/prometheus/client_golang v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v1.7.1/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20180712105110-5c3871d89910/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190129233127-fd36f4220a90/go.mod",
+		"github.com/prometheus/client_model v0.2.0/go.mod",
+		"github.com/prometheus/common v0.4.1/go.mod",
+		"github.com/prometheus/common v0.10.0/go.mod",
+		"github.com/prometheus/procfs v0.0.0-20181005140218-185b4288413d/go.mod",
+		"github.com/prometheus/procfs v0.0.2/go.mod",
+		"github.com/prometheus/procfs v0.1.3/go.mod",
+		"github.com/sirupsen/logrus v1.2.0/go.mod",
+		"github.com/sirupsen/logrus v1.4.2/go.mod",
+		"github.com/sirupsen/logrus v1.6.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.1.1/go.mod",
+		"github.com/stretchr/testify v1.2.2/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"golang.org/x/crypto v0.0.0-20180904163835-0709b304e793/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20181114220301-adae6a3d119a/go.mod",
+		"golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190613194153-d28f0bde5980/go.mod",
+		"golang.org/x/net v0.0.0-20200625001655-4c5254603344/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190226205417-e64efc72b421/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sys v0.0.0-20180905080454-ebe1bf3edb33/go.mod",
+		"golang.org/x/sys v0.0.0-20181116152217-5ac8a444bdc5/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190422165155-953cdadca894/go.mod",
+		"golang.org/x/sys v0.0.0-20200106162015-b016eb3dc98e/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200615200032-f1bc736245b1/go.mod",
+		"golang.org/x/sys v0.0.0-20200625212154-ddb9806d33ae/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"gopkg.in/alecthomas/kingpin.v2 v2.2.6/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15/go.mod",
+		"gopkg.in/yaml.v2 v2.2.1/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.2.4/go.mod",
+		"gopkg.in/yaml.v2 v2.2.5/go.mod",
+		"gopkg.in/yaml.v2 v2.3.0/go.mod",
+	},
+	"github.com/prometheus/common@v0.32.1": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"cloud.google.com/go v0.34.0/go.mod",
+		"cloud.google.com/go v0.38.0/go.mod",
+		"cloud.google.com/go v0.44.1/go.mod",
+		"cloud.google.com/go v0.44.2/go.mod",
+		"cloud.google.com/go v0.45.1/go.mod",
+		"cloud.google.com/go v0.46.3/go.mod",
+		"cloud.google.com/go v0.50.0/go.mod",
+		"cloud.google.com/go v0.52.0/go.mod",
+		"cloud.google.com/go v0.53.0/go.mod",
+		"cloud.google.com/go v0.54.0/go.mod",
+		"cloud.google.com/go v0.56.0/go.mod",
+		"cloud.google.com/go v0.57.0/go.mod",
+		"cloud.google.com/go v0.62.0/go.mod",
+		"cloud.google.com/go v0.65.0/go.mod",
+		"cloud.google.com/go/bigquery v1.0.1/go.mod",
+		"cloud.google.com/go/bigquery v1.3.0/go.mod",
+		"cloud.google.com/go/bigquery v1.4.0/go.mod",
+		"cloud.google.com/go/bigquery v1.5.0/go.mod",
+		"cloud.google.com/go/bigquery v1.7.0/go.mod",
+		"cloud.google.com/go/bigquery v1.8.0/go.mod",
+		"cloud.google.com/go/datastore v1.0.0/go.mod",
+		"cloud.google.com/go/datastore v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.0.1/go.mod",
+		"cloud.google.com/go/pubsub v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.2.0/go.mod",
+		"cloud.google.com/go/pubsub v1.3.1/go.mod",
+		"cloud.google.com/go/storage v1.0.0/go.mod",
+		"cloud.google.com/go/storage v1.5.0/go.mod",
+		"cloud.google.com/go/storage v1.6.0/go.mod",
+		"cloud.google.com/go/storage v1.8.0/go.mod",
+		"cloud.google.com/go/storage v1.10.0/go.mod",
+		"dmitri.shuralyov.com/gpu/mtl v0.0.0-20190408044501-666a987793e9/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/BurntSushi/xgb v0.0.0-20160522181843-27f122750802/go.mod",
+		"github.com/alecthomas/template v0.0.0-20160405071501-a0175ee3bccc/go.mod",
+		"github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751/go.mod",
+		"github.com/alecthomas/units v0.0.0-20151022065526-2efee857e7cf/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190717042225-c3de453c63f4/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190924025748-f65c72e2690d/go.mod",
+		"github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973/go.mod",
+		"github.com/beorn7/perks v1.0.0/go.mod",
+		"github.com/beorn7/perks v1.0.1/go.mod",
+		"github.com/census-instrumentation/opencensus-proto v0.2.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.1.1/go.mod",
+		"github.com/chzyer/logex v1.1.10/go.mod",
+		"github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e/go.mod",
+		"github.com/chzyer/test v0.0.0-20180213035817-a1ea475d72b1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/cncf/udpa/go v0.0.0-20191209042840-269d4d468f6f/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.1-0.20191026205805-5f8ba28d4473/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.4/go.mod",
+		"github.com/envoyproxy/protoc-gen-validate v0.1.0/go.mod",
+		"github.com/go-gl/glfw v0.0.0-20190409004039-e6da0acd62b1/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20191125211704-12ad95a8df72/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20200222043503-6f7a984d4dc4/go.mod",
+		"github.com/go-kit/kit v0.8.0/go.mod",
+		"github.com/go-kit/kit v0.9.0/go.mod",
+		"github.com/go-kit/log v0.1.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.3.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.4.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.5.0/go.mod",
+		"github.com/go-stack/stack v1.8.0/go.mod",
+		"github.com/gogo/protobuf v1.1.1/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6/go.mod",
+		"github.com/golang/groupcache v0.0.0-20191227052852-215e87163ea7/go.mod",
+		"github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/mock v1.2.0/go.mod",
+		"github.com/golang/mock v1.3.1/go.mod",
+		"github.com/golang/mock v1.4.0/go.mod",
+		"github.com/golang/mock v1.4.1/go.mod",
+		"github.com/golang/mock v1.4.3/go.mod",
+		"github.com/golang/mock v1.4.4/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.3.3/go.mod",
+		"github.com/golang/protobuf v1.3.4/go.mod",
+		"github.com/golang/protobuf v1.3.5/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.1/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/golang/protobuf v1.4.3/go.mod",
+		"github.com/google/btree v0.0.0-20180813153112-4030bb1f1f0c/go.mod",
+		"github.com/google/btree v1.0.0/go.mod",
+		"github.com/google/go-cmp v0.2.0/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.4.1/go.mod",
+		"github.com/google/go-cmp v0.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.1/go.mod",
+		"github.com/google/go-cmp v0.5.4/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/google/gofuzz v1.0.0/go.mod",
+		"github.com/google/martian v2.1.0+incompatible/go.mod",
+		"github.com/google/martian/v3 v3.0.0/go.mod",
+		"github.com/google/pprof v0.0.0-20181206194817-3ea8567a2e57/go.mod",
+		"github.com/google/pprof v0.0.0-20190515194954-54271f7e092f/go.mod",
+		"github.com/google/pprof v0.0.0-20191218002539-d4f498aebedc/go.mod",
+		"github.com/google/pprof v0.0.0-20200212024743-f11f1df84d12/go.mod",
+		"github.com/google/pprof v0.0.0-20200229191704-1ebb73c60ed3/go.mod",
+		"github.com/google/pprof v0.0.0-20200430221834-fc25d7d30c6d/go.mod",
+		"github.com/google/pprof v0.0.0-20200708004538-1a94d8640e99/go.mod",
+		"github.com/google/renameio v0.1.0/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.4/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.5/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.0/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.1/go.mod",
+		"github.com/ianlancetaylor/demangle v0.0.0-20181102032728-5e5cf60278f6/go.mod",
+		"github.com/jpillora/backoff v1.0.0/go.mod",
+		"github.com/json-iterator/go v1.1.6/go.mod",
+		"github.com/json-iterator/go v1.1.10/go.mod",
+		"github.com/json-iterator/go v1.1.11/go.mod",
+		"github.com/jstemmer/go-junit-report v0.0.0-20190106144839-af01ea7f8024/go.mod",
+		"github.com/jstemmer/go-junit-report v0.9.1/go.mod",
+		"github.com/julienschmidt/httprouter v1.2.0/go.mod",
+		"github.com/julienschmidt/httprouter v1.3.0/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.1/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.3/go.mod",
+		"github.com/kr/logfmt v0.0.0-20140226030751-b84e30acd515/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/matttproud/golang_protobuf_extensions v1.0.1/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180228061459-e0a39a4cb421/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd/go.mod",
+		"github.com/modern-go/reflect2 v0.0.0-20180701023420-4b7aa43c6742/go.mod",
+		"github.com/modern-go/reflect2 v1.0.1/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20161129095857-cc309e4a2223/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20190716064945-2f068394615f/go.mod",
+		"github.com/pkg/errors v0.8.0/go.mod",
+		"github.com/pkg/errors v0.8.1/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v0.9.1/go.mod",
+		"github.com/prometheus/client_golang v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v1.7.1/go.mod",
+		"github.com/prometheus/client_golang v1.11.0/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20180712105110-5c3871d89910/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190129233127-fd36f4220a90/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4/go.mod",
+		"github.com/prometheus/client_model v0.2.0/go.mod",
+		"github.com/prometheus/common v0.4.1/go.mod",
+		"github.com/prometheus/common v0.10.0/go.mod",
+		"github.com/prometheus/common v0.26.0/go.mod",
+		"github.com/prometheus/procfs v0.0.0-20181005140218-185b4288413d/go.mod",
+		"github.com/prometheus/procfs v0.0.2/go.mod",
+		"github.com/prometheus/procfs v0.1.3/go.mod",
+		"github.com/prometheus/procfs v0.6.0/go.mod",
+		"github.com/rogpeppe/go-internal v1.3.0/go.mod",
+		"github.com/sirupsen/logrus v1.2.0/go.mod",
+		"github.com/sirupsen/logrus v1.4.2/go.mod",
+		"github.com/sirupsen/logrus v1.6.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.1.1/go.mod",
+		"github.com/stretchr/testify v1.2.2/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/yuin/goldmark v1.1.25/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.1.32/go.mod",
+		"go.opencensus.io v0.21.0/go.mod",
+		"go.opencensus.io v0.22.0/go.mod",
+		"go.opencensus.io v0.22.2/go.mod",
+		"go.opencensus.io v0.22.3/go.mod",
+		"go.opencensus.io v0.22.4/go.mod",
+		"golang.org/x/crypto v0.0.0-20180904163835-0709b304e793/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20190510104115-cbcb75029529/go.mod",
+		"golang.org/x/crypto v0.0.0-20190605123033-f99c8df09eb5/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/exp v0.0.0-20190306152737-a1d7652674e8/go.mod",
+		"golang.org/x/exp v0.0.0-20190510132918-efd6b22b2522/go.mod",
+		"golang.org/x/exp v0.0.0-20190829153037-c13cbed26979/go.mod",
+		"golang.org/x/exp v0.0.0-20191030013958-a1ab85dbe136/go.mod",
+		"golang.org/x/exp v0.0.0-20191129062945-2f5052295587/go.mod",
+		"golang.org/x/exp v0.0.0-20191227195350-da58074b4299/go.mod",
+		"golang.org/x/exp v0.0.0-20200119233911-0405dc783f0a/go.mod",
+		"golang.org/x/exp v0.0.0-20200207192155-f17229e696bd/go.mod",
+		"golang.org/x/exp v0.0.0-20200224162631-6cc2880d07d6/go.mod",
+		"golang.org/x/image v0.0.0-20190227222117-0694c2d4d067/go.mod",
+		"golang.org/x/image v0.0.0-20190802002840-cff245a6509b/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190301231843-5614ed5bae6f/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/lint v0.0.0-20190409202823-959b441ac422/go.mod",
+		"golang.org/x/lint v0.0.0-20190909230951-414d861bb4ac/go.mod",
+		"golang.org/x/lint v0.0.0-20190930215403-16217165b5de/go.mod",
+		"golang.org/x/lint v0.0.0-20191125180803-fdd1cda4f05f/go.mod",
+		"golang.org/x/lint v0.0.0-20200130185559-910be7a94367/go.mod",
+		"golang.org/x/lint v0.0.0-20200302205851-738671d3881b/go.mod",
+		"golang.org/x/mobile v0.0.0-20190312151609-d3739f865fa6/go.mod",
+		"golang.org/x/mobile v0.0.0-20190719004257-d2bd2a29d028/go.mod",
+		"golang.org/x/mod v0.0.0-20190513183733-4bf6d317e70e/go.mod",
+		"golang.org/x/mod v0.1.0/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191105210325-c90efee705ee/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191107180719-034126e5016b/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20181114220301-adae6a3d119a/go.mod",
+		"golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190501004415-9ce7a6920f09/go.mod",
+		"golang.org/x/net v0.0.0-20190503192946-f4e77d36d62c/go.mod",
+		"golang.org/x/net v0.0.0-20190603091049-60506f45cf65/go.mod",
+		"golang.org/x/net v0.0.0-20190613194153-d28f0bde5980/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20190628185345-da137c7871d7/go.mod",
+		"golang.org/x/net v0.0.0-20190724013045-ca1201d0de80/go.mod",
+		"golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/net v0.0.0-20200202094626-16171245cfb2/go.mod",
+		"golang.org/x/net v0.0.0-20200222125558-5a598a2470a0/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20200301022130-244492dfa37a/go.mod",
+		"golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e/go.mod",
+		"golang.org/x/net v0.0.0-20200501053045-e0ff5e5a1de5/go.mod",
+		"golang.org/x/net v0.0.0-20200506145744-7e3656a0809f/go.mod",
+		"golang.org/x/net v0.0.0-20200513185701-a91f0712d120/go.mod",
+		"golang.org/x/net v0.0.0-20200520182314-0ba52f642ac2/go.mod",
+		"golang.org/x/net v0.0.0-20200625001655-4c5254603344/go.mod",
+		"golang.org/x/net v0.0.0-20200707034311-ab3426394381/go.mod",
+		"golang.org/x/net v0.0.0-20200822124328-c89045814202/go.mod",
+		"golang.org/x/net v0.0.0-20210525063256-abc453219eb5/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190226205417-e64efc72b421/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20191202225959-858c2ad4c8b6/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20210514164344-f6687ab2804c/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+		"golang.org/x/sync v0.0.0-20190227155943-e225da77a7e6/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20200317015054-43a5402ce75a/go.mod",
+		"golang.org/x/sync v0.0.0-20200625203802-6e8e738ad208/go.mod",
+		"golang.org/x/sync v0.0.0-20201207232520-09787c993a3a/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20180905080454-ebe1bf3edb33/go.mod",
+		"golang.org/x/sys v0.0.0-20181116152217-5ac8a444bdc5/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190312061237-fead79001313/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190422165155-953cdadca894/go.mod",
+		"golang.org/x/sys v0.0.0-20190502145724-3ef323f4f1fd/go.mod",
+		"golang.org/x/sys v0.0.0-20190507160741-ecd444e8653b/go.mod",
+		"golang.org/x/sys v0.0.0-20190606165138-5da285871e9c/go.mod",
+		"golang.org/x/sys v0.0.0-20190624142023-c5567b49c5d0/go.mod",
+		"golang.org/x/sys v0.0.0-20190726091711-fc99dfbffb4e/go.mod",
+		"golang.org/x/sys v0.0.0-20191001151750-bb3f8db39f24/go.mod",
+		"golang.org/x/sys v0.0.0-20191204072324-ce4227a45e2e/go.mod",
+		"golang.org/x/sys v0.0.0-20191228213918-04cbcbbfeed8/go.mod",
+		"golang.org/x/sys v0.0.0-20200106162015-b016eb3dc98e/go.mod",
+		"golang.org/x/sys v0.0.0-20200113162924-86b910548bc1/go.mod",
+		"golang.org/x/sys v0.0.0-20200122134326-e047566fdf82/go.mod",
+		"golang.org/x/sys v0.0.0-20200202164722-d101bd2416d5/go.mod",
+		"golang.org/x/sys v0.0.0-20200212091648-12a6c2dcc1e4/go.mod",
+		"golang.org/x/sys v0.0.0-20200223170610-d5e6a3e2c0ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200302150141-5c8b2ff67527/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200331124033-c3d80250170d/go.mod",
+		"golang.org/x/sys v0.0.0-20200501052902-10377860bb8e/go.mod",
+		"golang.org/x/sys v0.0.0-20200511232937-7e40ca221e25/go.mod",
+		"golang.org/x/sys v0.0.0-20200515095857-1151b9dac4a9/go.mod",
+		"golang.org/x/sys v0.0.0-20200523222454-059865788121/go.mod",
+		"golang.org/x/sys v0.0.0-20200615200032-f1bc736245b1/go.mod",
+		"golang.org/x/sys v0.0.0-20200625212154-ddb9806d33ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200803210538-64077c9b5642/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210124154548-22da62e12c0c/go.mod",
+		"golang.org/x/sys v0.0.0-20210423082822-04245dca01da/go.mod",
+		"golang.org/x/sys v0.0.0-20210603081109-ebe580a85c40/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/text v0.0.0-20170915032832-14c0d48ead0c/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.1-0.20180807135948-17ff2d5776d2/go.mod",
+		"golang.org/x/text v0.3.2/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.6/go.mod",
+		"golang.org/x/time v0.0.0-20181108054448-85acf8d2951c/go.mod",
+		"golang.org/x/time v0.0.0-20190308202827-9d24e82272b4/go.mod",
+		"golang.org/x/time v0.0.0-20191024005414-555d28b269f0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20190312151545-0bb0c0a6e846/go.mod",
+		"golang.org/x/tools v0.0.0-20190312170243-e65039ee4138/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+		"golang.org/x/tools v0.0.0-20190506145303-2d16b83fe98c/go.mod",
+		"golang.org/x/tools v0.0.0-20190524140312-2c0ae7006135/go.mod",
+		"golang.org/x/tools v0.0.0-20190606124116-d0a3d012864b/go.mod",
+		"golang.org/x/tools v0.0.0-20190621195816-6e04913cbbac/go.mod",
+		"golang.org/x/tools v0.0.0-20190628153133-6cdbf07be9d0/go.mod",
+		"golang.org/x/tools v0.0.0-20190816200558-6889da9d5479/go.mod",
+		"golang.org/x/tools v0.0.0-20190911174233-4f2ddba30aff/go.mod",
+		"golang.org/x/tools v0.0.0-20191012152004-8de300cfc20a/go.mod",
+		"golang.org/x/tools v0.0.0-20191113191852-77e3bb0ad9e7/go.mod",
+		"golang.org/x/tools v0.0.0-20191115202509-3a792d9c32b2/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20191125144606-a911d9008d1f/go.mod",
+		"golang.org/x/tools v0.0.0-20191130070609-6e064ea0cf2d/go.mod",
+		"golang.org/x/tools v0.0.0-20191216173652-a0e659d51361/go.mod",
+		"golang.org/x/tools v0.0.0-20191227053925-7b8e75db28f4/go.mod",
+		"golang.org/x/tools v0.0.0-20200117161641-43d50277825c/go.mod",
+		"golang.org/x/tools v0.0.0-20200122220014-bf1340f18c4a/go.mod",
+		"golang.org/x/tools v0.0.0-20200130002326-2f3ba24bd6e7/go.mod",
+		"golang.org/x/tools v0.0.0-20200204074204-1cc6d1ef6c74/go.mod",
+		"golang.org/x/tools v0.0.0-20200207183749-b753a1ba74fa/go.mod",
+		"golang.org/x/tools v0.0.0-20200212150539-ea181f53ac56/go.mod",
+		"golang.org/x/tools v0.0.0-20200224181240-023911ca70b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200227222343-706bc42d1f0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200304193943-95d2e580d8eb/go.mod",
+		"golang.org/x/tools v0.0.0-20200312045724-11d5b4c81c7d/go.mod",
+		"golang.org/x/tools v0.0.0-20200331025713-a30bf2db82d4/go.mod",
+		"golang.org/x/tools v0.0.0-20200501065659-ab2804fb9c9d/go.mod",
+		"golang.org/x/tools v0.0.0-20200512131952-2bc93b1c0c88/go.mod",
+		"golang.org/x/tools v0.0.0-20200515010526-7d3b6ebf133d/go.mod",
+		"golang.org/x/tools v0.0.0-20200618134242-20370b0cb4b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200729194436-6467de6f59a7/go.mod",
+		"golang.org/x/tools v0.0.0-20200804011535-6c149bb5ef0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200825202427-b303f430e36d/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/api v0.4.0/go.mod",
+		"google.golang.org/api v0.7.0/go.mod",
+		"google.golang.org/api v0.8.0/go.mod",
+		"google.golang.org/api v0.9.0/go.mod",
+		"google.golang.org/api v0.13.0/go.mod",
+		"google.golang.org/api v0.14.0/go.mod",
+		"google.golang.org/api v0.15.0/go.mod",
+		"google.golang.org/api v0.17.0/go.mod",
+		"google.golang.org/api v0.18.0/go.mod",
+		"google.golang.org/api v0.19.0/go.mod",
+		"google.golang.org/api v0.20.0/go.mod",
+		"google.golang.org/api v0.22.0/go.mod",
+		"google.golang.org/api v0.24.0/go.mod",
+		"google.golang.org/api v0.28.0/go.mod",
+		"google.golang.org/api v0.29.0/go.mod",
+		"google.golang.org/api v0.30.0/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/appengine v1.5.0/go.mod",
+		"google.golang.org/appengine v1.6.1/go.mod",
+		"google.golang.org/appengine v1.6.5/go.mod",
+		"google.golang.org/appengine v1.6.6/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190307195333-5fe7a883aa19/go.mod",
+		"google.golang.org/genproto v0.0.0-20190418145605-e7d98fc518a7/go.mod",
+		"google.golang.org/genproto v0.0.0-20190425155659-357c62f0e4bb/go.mod",
+		"google.golang.org/genproto v0.0.0-20190502173448-54afdca5d873/go.mod",
+		"google.golang.org/genproto v0.0.0-20190801165951-fa694d86fc64/go.mod",
+		"google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55/go.mod",
+		"google.golang.org/genproto v0.0.0-20190911173649-1774047e7e51/go.mod",
+		"google.golang.org/genproto v0.0.0-20191108220845-16a3f7862a1a/go.mod",
+		"google.golang.org/genproto v0.0.0-20191115194625-c23dd37a84c9/go.mod",
+		"google.golang.org/genproto v0.0.0-20191216164720-4f79533eabd1/go.mod",
+		"google.golang.org/genproto v0.0.0-20191230161307-f3c370f40bfb/go.mod",
+		"google.golang.org/genproto v0.0.0-20200115191322-ca5a22157cba/go.mod",
+		"google.golang.org/genproto v0.0.0-20200122232147-0452cf42e150/go.mod",
+		"google.golang.org/genproto v0.0.0-20200204135345-fa8e72b47b90/go.mod",
+		"google.golang.org/genproto v0.0.0-20200212174721-66ed5ce911ce/go.mod",
+		"google.golang.org/genproto v0.0.0-20200224152610-e50cd9704f63/go.mod",
+		"google.golang.org/genproto v0.0.0-20200228133532-8c2c7df3a383/go.mod",
+		"google.golang.org/genproto v0.0.0-20200305110556-506484158171/go.mod",
+		"google.golang.org/genproto v0.0.0-20200312145019-da6875a35672/go.mod",
+		"google.golang.org/genproto v0.0.0-20200331122359-1ee6d9798940/go.mod",
+		"google.golang.org/genproto v0.0.0-20200430143042-b979b6f78d84/go.mod",
+		"google.golang.org/genproto v0.0.0-20200511104702-f5ebc3bea380/go.mod",
+		"google.golang.org/genproto v0.0.0-20200515170657-fc4c6c6a6587/go.mod",
+		"google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013/go.mod",
+		"google.golang.org/genproto v0.0.0-20200618031413-b414f8b61790/go.mod",
+		"google.golang.org/genproto v0.0.0-20200729003335-053ba62fc06f/go.mod",
+		"google.golang.org/genproto v0.0.0-20200804131852-c06518451d9c/go.mod",
+		"google.golang.org/genproto v0.0.0-20200825200019-8632dd797987/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.20.1/go.mod",
+		"google.golang.org/grpc v1.21.1/go.mod",
+		"google.golang.org/grpc v1.23.0/go.mod",
+		"google.golang.org/grpc v1.25.1/go.mod",
+		"google.golang.org/grpc v1.26.0/go.mod",
+		"google.golang.org/grpc v1.27.0/go.mod",
+		"google.golang.org/grpc v1.27.1/go.mod",
+		"google.golang.org/grpc v1.28.0/go.mod",
+		"google.golang.org/grpc v1.29.1/go.mod",
+		"google.golang.org/grpc v1.30.0/go.mod",
+		"google.golang.org/grpc v1.31.0/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.22.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.23.1-0.20200526195155-81db48ad09cc/go.mod",
+		"google.golang.org/protobuf v1.24.0/go.mod",
+		"google.golang.org/protobuf v1.25.0/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"gopkg.in/alecthomas/kingpin.v2 v2.2.6/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15/go.mod",
+		"gopkg.in/errgo.v2 v2.1.0/go.mod",
+		"gopkg.in/yaml.v2 v2.2.1/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.2.4/go.mod",
+		"gopkg.in/yaml.v2 v2.2.5/go.mod",
+		"gopkg.in/yaml.v2 v2.3.0/go.mod",
+		"gopkg.in/yaml.v2 v2.4.0/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+		"honnef.co/go/tools v0.0.0-20190106161140-3f1c8253044a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190418001031-e561f6794a2a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190523083050-ea95bdfd59fc/go.mod",
+		"honnef.co/go/tools v0.0.1-2019.2.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.4/go.mod",
+		"rsc.io/binaryregexp v0.2.0/go.mod",
+		"rsc.io/quote/v3 v3.1.0/go.mod",
+		"rsc.io/sampler v1.3.0/go.mod",
+	},
+	"github.com/prometheus/common@v0.37.0": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"cloud.google.com/go v0.34.0/go.mod",
+		"cloud.google.com/go v0.38.0/go.mod",
+		"cloud.google.com/go v0.44.1/go.mod",
+		"cloud.google.com/go v0.44.2/go.mod",
+		"cloud.google.com/go v0.45.1/go.mod",
+		"cloud.google.com/go v0.46.3/go.mod",
+		"cloud.google.com/go v0.50.0/go.mod",
+		"cloud.google.com/go v0.52.0/go.mod",
+		"cloud.google.com/go v0.53.0/go.mod",
+		"cloud.google.com/go v0.54.0/go.mod",
+		"cloud.google.com/go v0.56.0/go.mod",
+		"cloud.google.com/go v0.57.0/go.mod",
+		"cloud.google.com/go v0.62.0/go.mod",
+		"cloud.google.com/go v0.65.0/go.mod",
+		"cloud.google.com/go/bigquery v1.0.1/go.mod",
+		"cloud.google.com/go/bigquery v1.3.0/go.mod",
+		"cloud.google.com/go/bigquery v1.4.0/go.mod",
+		"cloud.google.com/go/bigquery v1.5.0/go.mod",
+		"cloud.google.com/go/bigquery v1.7.0/go.mod",
+		"cloud.google.com/go/bigquery v1.8.0/go.mod",
+		"cloud.google.com/go/datastore v1.0.0/go.mod",
+		"cloud.google.com/go/datastore v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.0.1/go.mod",
+		"cloud.google.com/go/pubsub v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.2.0/go.mod",
+		"cloud.google.com/go/pubsub v1.3.1/go.mod",
+		"cloud.google.com/go/storage v1.0.0/go.mod",
+		"cloud.google.com/go/storage v1.5.0/go.mod",
+		"cloud.google.com/go/storage v1.6.0/go.mod",
+		"cloud.google.com/go/storage v1.8.0/go.mod",
+		"cloud.google.com/go/storage v1.10.0/go.mod",
+		"dmitri.shuralyov.com/gpu/mtl v0.0.0-20190408044501-666a987793e9/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/BurntSushi/xgb v0.0.0-20160522181843-27f122750802/go.mod",
+		"github.com/alecthomas/template v0.0.0-20160405071501-a0175ee3bccc/go.mod",
+		"github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751/go.mod",
+		"github.com/alecthomas/units v0.0.0-20151022065526-2efee857e7cf/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190717042225-c3de453c63f4/go.mod",
+		"github.com/alecthomas/units v0.0.0-20190924025748-f65c72e2690d/go.mod",
+		"github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973/go.mod",
+		"github.com/beorn7/perks v1.0.0/go.mod",
+		"github.com/beorn7/perks v1.0.1/go.mod",
+		"github.com/census-instrumentation/opencensus-proto v0.2.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.1.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.1.2/go.mod",
+		"github.com/chzyer/logex v1.1.10/go.mod",
+		"github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e/go.mod",
+		"github.com/chzyer/test v0.0.0-20180213035817-a1ea475d72b1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/cncf/udpa/go v0.0.0-20191209042840-269d4d468f6f/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.1-0.20191026205805-5f8ba28d4473/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.4/go.mod",
+		"github.com/envoyproxy/protoc-gen-validate v0.1.0/go.mod",
+		"github.com/go-gl/glfw v0.0.0-20190409004039-e6da0acd62b1/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20191125211704-12ad95a8df72/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20200222043503-6f7a984d4dc4/go.mod",
+		"github.com/go-kit/kit v0.8.0/go.mod",
+		"github.com/go-kit/kit v0.9.0/go.mod",
+		"github.com/go-kit/log v0.1.0/go.mod",
+		"github.com/go-kit/log v0.2.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.3.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.4.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.5.0/go.mod",
+		"github.com/go-logfmt/logfmt v0.5.1/go.mod",
+		"github.com/go-stack/stack v1.8.0/go.mod",
+		"github.com/gogo/protobuf v1.1.1/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6/go.mod",
+		"github.com/golang/groupcache v0.0.0-20191227052852-215e87163ea7/go.mod",
+		"github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/mock v1.2.0/go.mod",
+		"github.com/golang/mock v1.3.1/go.mod",
+		"github.com/golang/mock v1.4.0/go.mod",
+		"github.com/golang/mock v1.4.1/go.mod",
+		"github.com/golang/mock v1.4.3/go.mod",
+		"github.com/golang/mock v1.4.4/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.3.3/go.mod",
+		"github.com/golang/protobuf v1.3.4/go.mod",
+		"github.com/golang/protobuf v1.3.5/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.1/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/golang/protobuf v1.4.3/go.mod",
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/golang/protobuf v1.5.2/go.mod",
+		"github.com/google/btree v0.0.0-20180813153112-4030bb1f1f0c/go.mod",
+		"github.com/google/btree v1.0.0/go.mod",
+		"github.com/google/go-cmp v0.2.0/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.4.1/go.mod",
+		"github.com/google/go-cmp v0.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.1/go.mod",
+		"github.com/google/go-cmp v0.5.4/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/google/gofuzz v1.0.0/go.mod",
+		"github.com/google/martian v2.1.0+incompatible/go.mod",
+		"github.com/google/martian/v3 v3.0.0/go.mod",
+		"github.com/google/pprof v0.0.0-20181206194817-3ea8567a2e57/go.mod",
+		"github.com/google/pprof v0.0.0-20190515194954-54271f7e092f/go.mod",
+		"github.com/google/pprof v0.0.0-20191218002539-d4f498aebedc/go.mod",
+		"github.com/google/pprof v0.0.0-20200212024743-f11f1df84d12/go.mod",
+		"github.com/google/pprof v0.0.0-20200229191704-1ebb73c60ed3/go.mod",
+		"github.com/google/pprof v0.0.0-20200430221834-fc25d7d30c6d/go.mod",
+		"github.com/google/pprof v0.0.0-20200708004538-1a94d8640e99/go.mod",
+		"github.com/google/renameio v0.1.0/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.4/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.5/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.0/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.1/go.mod",
+		"github.com/ianlancetaylor/demangle v0.0.0-20181102032728-5e5cf60278f6/go.mod",
+		"github.com/jpillora/backoff v1.0.0/go.mod",
+		"github.com/json-iterator/go v1.1.6/go.mod",
+		"github.com/json-iterator/go v1.1.10/go.mod",
+		"github.com/json-iterator/go v1.1.11/go.mod",
+		"github.com/json-iterator/go v1.1.12/go.mod",
+		"github.com/jstemmer/go-junit-report v0.0.0-20190106144839-af01ea7f8024/go.mod",
+		"github.com/jstemmer/go-junit-report v0.9.1/go.mod",
+		"github.com/julienschmidt/httprouter v1.2.0/go.mod",
+		"github.com/julienschmidt/httprouter v1.3.0/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.1/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.3/go.mod",
+		"github.com/kr/logfmt v0.0.0-20140226030751-b84e30acd515/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/matttproud/golang_protobuf_extensions v1.0.1/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180228061459-e0a39a4cb421/go.mod",
+		"github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd/go.mod",
+		"github.com/modern-go/reflect2 v0.0.0-20180701023420-4b7aa43c6742/go.mod",
+		"github.com/modern-go/reflect2 v1.0.1/go.mod",
+		"github.com/modern-go/reflect2 v1.0.2/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20161129095857-cc309e4a2223/go.mod",
+		"github.com/mwitkow/go-conntrack v0.0.0-20190716064945-2f068394615f/go.mod",
+		"github.com/pkg/errors v0.8.0/go.mod",
+		"github.com/pkg/errors v0.8.1/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v0.9.1/go.mod",
+		"github.com/prometheus/client_golang v1.0.0/go.mod",
+		"github.com/prometheus/client_golang v1.7.1/go.mod",
+		"github.com/prometheus/client_golang v1.11.0/go.mod",
+		"github.com/prometheus/client_golang v1.12.1/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20180712105110-5c3871d89910/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190129233127-fd36f4220a90/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4/go.mod",
+		"github.com/prometheus/client_model v0.2.0/go.mod",
+		"github.com/prometheus/common v0.4.1/go.mod",
+		"github.com/prometheus/common v0.10.0/go.mod",
+		"github.com/prometheus/common v0.26.0/go.mod",
+		"github.com/prometheus/common v0.32.1/go.mod",
+		"github.com/prometheus/procfs v0.0.0-20181005140218-185b4288413d/go.mod",
+		"github.com/prometheus/procfs v0.0.2/go.mod",
+		"github.com/prometheus/procfs v0.1.3/go.mod",
+		"github.com/prometheus/procfs v0.6.0/go.mod",
+		"github.com/prometheus/procfs v0.7.3/go.mod",
+		"github.com/rogpeppe/go-internal v1.3.0/go.mod",
+		"github.com/sirupsen/logrus v1.2.0/go.mod",
+		"github.com/sirupsen/logrus v1.4.2/go.mod",
+		"github.com/sirupsen/logrus v1.6.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.1.1/go.mod",
+		"github.com/stretchr/testify v1.2.2/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/yuin/goldmark v1.1.25/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.1.32/go.mod",
+		"go.opencensus.io v0.21.0/go.mod",
+		"go.opencensus.io v0.22.0/go.mod",
+		"go.opencensus.io v0.22.2/go.mod",
+		"go.opencensus.io v0.22.3/go.mod",
+		"go.opencensus.io v0.22.4/go.mod",
+		"golang.org/x/crypto v0.0.0-20180904163835-0709b304e793/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20190510104115-cbcb75029529/go.mod",
+		"golang.org/x/crypto v0.0.0-20190605123033-f99c8df09eb5/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/exp v0.0.0-20190306152737-a1d7652674e8/go.mod",
+		"golang.org/x/exp v0.0.0-20190510132918-efd6b22b2522/go.mod",
+		"golang.org/x/exp v0.0.0-20190829153037-c13cbed26979/go.mod",
+		"golang.org/x/exp v0.0.0-20191030013958-a1ab85dbe136/go.mod",
+		"golang.org/x/exp v0.0.0-20191129062945-2f5052295587/go.mod",
+		"golang.org/x/exp v0.0.0-20191227195350-da58074b4299/go.mod",
+		"golang.org/x/exp v0.0.0-20200119233911-0405dc783f0a/go.mod",
+		"golang.org/x/exp v0.0.0-20200207192155-f17229e696bd/go.mod",
+		"golang.org/x/exp v0.0.0-20200224162631-6cc2880d07d6/go.mod",
+		"golang.org/x/image v0.0.0-20190227222117-0694c2d4d067/go.mod",
+		"golang.org/x/image v0.0.0-20190802002840-cff245a6509b/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190301231843-5614ed5bae6f/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/lint v0.0.0-20190409202823-959b441ac422/go.mod",
+		"golang.org/x/lint v0.0.0-20190909230951-414d861bb4ac/go.mod",
+		"golang.org/x/lint v0.0.0-20190930215403-16217165b5de/go.mod",
+		"golang.org/x/lint v0.0.0-20191125180803-fdd1cda4f05f/go.mod",
+		"golang.org/x/lint v0.0.0-20200130185559-910be7a94367/go.mod",
+		"golang.org/x/lint v0.0.0-20200302205851-738671d3881b/go.mod",
+		"golang.org/x/mobile v0.0.0-20190312151609-d3739f865fa6/go.mod",
+		"golang.org/x/mobile v0.0.0-20190719004257-d2bd2a29d028/go.mod",
+		"golang.org/x/mod v0.0.0-20190513183733-4bf6d317e70e/go.mod",
+		"golang.org/x/mod v0.1.0/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191105210325-c90efee705ee/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191107180719-034126e5016b/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20181114220301-adae6a3d119a/go.mod",
+		"golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190501004415-9ce7a6920f09/go.mod",
+		"golang.org/x/net v0.0.0-20190503192946-f4e77d36d62c/go.mod",
+		"golang.org/x/net v0.0.0-20190603091049-60506f45cf65/go.mod",
+		"golang.org/x/net v0.0.0-20190613194153-d28f0bde5980/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20190628185345-da137c7871d7/go.mod",
+		"golang.org/x/net v0.0.0-20190724013045-ca1201d0de80/go.mod",
+		"golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/net v0.0.0-20200202094626-16171245cfb2/go.mod",
+		"golang.org/x/net v0.0.0-20200222125558-5a598a2470a0/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20200301022130-244492dfa37a/go.mod",
+		"golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e/go.mod",
+		"golang.org/x/net v0.0.0-20200501053045-e0ff5e5a1de5/go.mod",
+		"golang.org/x/net v0.0.0-20200506145744-7e3656a0809f/go.mod",
+		"golang.org/x/net v0.0.0-20200513185701-a91f0712d120/go.mod",
+		"golang.org/x/net v0.0.0-20200520182314-0ba52f642ac2/go.mod",
+		"golang.org/x/net v0.0.0-20200625001655-4c5254603344/go.mod",
+		"golang.org/x/net v0.0.0-20200707034311-ab3426394381/go.mod",
+		"golang.org/x/net v0.0.0-20200822124328-c89045814202/go.mod",
+		"golang.org/x/net v0.0.0-20210525063256-abc453219eb5/go.mod",
+		"golang.org/x/net v0.0.0-20220127200216-cd36cc0744dd/go.mod",
+		"golang.org/x/net v0.0.0-20220225172249-27dd8689420f/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190226205417-e64efc72b421/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20191202225959-858c2ad4c8b6/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20210514164344-f6687ab2804c/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20220223155221-ee480838109b/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+		"golang.org/x/sync v0.0.0-20190227155943-e225da77a7e6/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20200317015054-43a5402ce75a/go.mod",
+		"golang.org/x/sync v0.0.0-20200625203802-6e8e738ad208/go.mod",
+		"golang.org/x/sync v0.0.0-20201207232520-09787c993a3a/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20180905080454-ebe1bf3edb33/go.mod",
+		"golang.org/x/sys v0.0.0-20181116152217-5ac8a444bdc5/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190312061237-fead79001313/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190422165155-953cdadca894/go.mod",
+		"golang.org/x/sys v0.0.0-20190502145724-3ef323f4f1fd/go.mod",
+		"golang.org/x/sys v0.0.0-20190507160741-ecd444e8653b/go.mod",
+		"golang.org/x/sys v0.0.0-20190606165138-5da285871e9c/go.mod",
+		"golang.org/x/sys v0.0.0-20190624142023-c5567b49c5d0/go.mod",
+		"golang.org/x/sys v0.0.0-20190726091711-fc99dfbffb4e/go.mod",
+		"golang.org/x/sys v0.0.0-20191001151750-bb3f8db39f24/go.mod",
+		"golang.org/x/sys v0.0.0-20191204072324-ce4227a45e2e/go.mod",
+		"golang.org/x/sys v0.0.0-20191228213918-04cbcbbfeed8/go.mod",
+		"golang.org/x/sys v0.0.0-20200106162015-b016eb3dc98e/go.mod",
+		"golang.org/x/sys v0.0.0-20200113162924-86b910548bc1/go.mod",
+		"golang.org/x/sys v0.0.0-20200122134326-e047566fdf82/go.mod",
+		"golang.org/x/sys v0.0.0-20200202164722-d101bd2416d5/go.mod",
+		"golang.org/x/sys v0.0.0-20200212091648-12a6c2dcc1e4/go.mod",
+		"golang.org/x/sys v0.0.0-20200223170610-d5e6a3e2c0ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200302150141-5c8b2ff67527/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200331124033-c3d80250170d/go.mod",
+		"golang.org/x/sys v0.0.0-20200501052902-10377860bb8e/go.mod",
+		"golang.org/x/sys v0.0.0-20200511232937-7e40ca221e25/go.mod",
+		"golang.org/x/sys v0.0.0-20200515095857-1151b9dac4a9/go.mod",
+		"golang.org/x/sys v0.0.0-20200523222454-059865788121/go.mod",
+		"golang.org/x/sys v0.0.0-20200615200032-f1bc736245b1/go.mod",
+		"golang.org/x/sys v0.0.0-20200625212154-ddb9806d33ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200803210538-64077c9b5642/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210124154548-22da62e12c0c/go.mod",
+		"golang.org/x/sys v0.0.0-20210423082822-04245dca01da/go.mod",
+		"golang.org/x/sys v0.0.0-20210603081109-ebe580a85c40/go.mod",
+		"golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1/go.mod",
+		"golang.org/x/sys v0.0.0-20211216021012-1d35b9e2eb4e/go.mod",
+		"golang.org/x/sys v0.0.0-20220114195835-da31bd327af9/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/term v0.0.0-20210927222741-03fcf44c2211/go.mod",
+		"golang.org/x/text v0.0.0-20170915032832-14c0d48ead0c/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.1-0.20180807135948-17ff2d5776d2/go.mod",
+		"golang.org/x/text v0.3.2/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.6/go.mod",
+		"golang.org/x/text v0.3.7/go.mod",
+		"golang.org/x/time v0.0.0-20181108054448-85acf8d2951c/go.mod",
+		"golang.org/x/time v0.0.0-20190308202827-9d24e82272b4/go.mod",
+		"golang.org/x/time v0.0.0-20191024005414-555d28b269f0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20190312151545-0bb0c0a6e846/go.mod",
+		"golang.org/x/tools v0.0.0-20190312170243-e65039ee4138/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+		"golang.org/x/tools v0.0.0-20190506145303-2d16b83fe98c/go.mod",
+		"golang.org/x/tools v0.0.0-20190524140312-2c0ae7006135/go.mod",
+		"golang.org/x/tools v0.0.0-20190606124116-d0a3d012864b/go.mod",
+		"golang.org/x/tools v0.0.0-20190621195816-6e04913cbbac/go.mod",
+		"golang.org/x/tools v0.0.0-20190628153133-6cdbf07be9d0/go.mod",
+		"golang.org/x/tools v0.0.0-20190816200558-6889da9d5479/go.mod",
+		"golang.org/x/tools v0.0.0-20190911174233-4f2ddba30aff/go.mod",
+		"golang.org/x/tools v0.0.0-20191012152004-8de300cfc20a/go.mod",
+		"golang.org/x/tools v0.0.0-20191113191852-77e3bb0ad9e7/go.mod",
+		"golang.org/x/tools v0.0.0-20191115202509-3a792d9c32b2/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20191125144606-a911d9008d1f/go.mod",
+		"golang.org/x/tools v0.0.0-20191130070609-6e064ea0cf2d/go.mod",
+		"golang.org/x/tools v0.0.0-20191216173652-a0e659d51361/go.mod",
+		"golang.org/x/tools v0.0.0-20191227053925-7b8e75db28f4/go.mod",
+		"golang.org/x/tools v0.0.0-20200117161641-43d50277825c/go.mod",
+		"golang.org/x/tools v0.0.0-20200122220014-bf1340f18c4a/go.mod",
+		"golang.org/x/tools v0.0.0-20200130002326-2f3ba24bd6e7/go.mod",
+		"golang.org/x/tools v0.0.0-20200204074204-1cc6d1ef6c74/go.mod",
+		"golang.org/x/tools v0.0.0-20200207183749-b753a1ba74fa/go.mod",
+		"golang.org/x/tools v0.0.0-20200212150539-ea181f53ac56/go.mod",
+		"golang.org/x/tools v0.0.0-20200224181240-023911ca70b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200227222343-706bc42d1f0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200304193943-95d2e580d8eb/go.mod",
+		"golang.org/x/tools v0.0.0-20200312045724-11d5b4c81c7d/go.mod",
+		"golang.org/x/tools v0.0.0-20200331025713-a30bf2db82d4/go.mod",
+		"golang.org/x/tools v0.0.0-20200501065659-ab2804fb9c9d/go.mod",
+		"golang.org/x/tools v0.0.0-20200512131952-2bc93b1c0c88/go.mod",
+		"golang.org/x/tools v0.0.0-20200515010526-7d3b6ebf133d/go.mod",
+		"golang.org/x/tools v0.0.0-20200618134242-20370b0cb4b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200729194436-6467de6f59a7/go.mod",
+		"golang.org/x/tools v0.0.0-20200804011535-6c149bb5ef0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200825202427-b303f430e36d/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/api v0.4.0/go.mod",
+		"google.golang.org/api v0.7.0/go.mod",
+		"google.golang.org/api v0.8.0/go.mod",
+		"google.golang.org/api v0.9.0/go.mod",
+		"google.golang.org/api v0.13.0/go.mod",
+		"google.golang.org/api v0.14.0/go.mod",
+		"google.golang.org/api v0.15.0/go.mod",
+		"google.golang.org/api v0.17.0/go.mod",
+		"google.golang.org/api v0.18.0/go.mod",
+		"google.golang.org/api v0.19.0/go.mod",
+		"google.golang.org/api v0.20.0/go.mod",
+		"google.golang.org/api v0.22.0/go.mod",
+		"google.golang.org/api v0.24.0/go.mod",
+		"google.golang.org/api v0.28.0/go.mod",
+		"google.golang.org/api v0.29.0/go.mod",
+		"google.golang.org/api v0.30.0/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/appengine v1.5.0/go.mod",
+		"google.golang.org/appengine v1.6.1/go.mod",
+		"google.golang.org/appengine v1.6.5/go.mod",
+		"google.golang.org/appengine v1.6.6/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190307195333-5fe7a883aa19/go.mod",
+		"google.golang.org/genproto v0.0.0-20190418145605-e7d98fc518a7/go.mod",
+		"google.golang.org/genproto v0.0.0-20190425155659-357c62f0e4bb/go.mod",
+		"google.golang.org/genproto v0.0.0-20190502173448-54afdca5d873/go.mod",
+		"google.golang.org/genproto v0.0.0-20190801165951-fa694d86fc64/go.mod",
+		"google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55/go.mod",
+		"google.golang.org/genproto v0.0.0-20190911173649-1774047e7e51/go.mod",
+		"google.golang.org/genproto v0.0.0-20191108220845-16a3f7862a1a/go.mod",
+		"google.golang.org/genproto v0.0.0-20191115194625-c23dd37a84c9/go.mod",
+		"google.golang.org/genproto v0.0.0-20191216164720-4f79533eabd1/go.mod",
+		"google.golang.org/genproto v0.0.0-20191230161307-f3c370f40bfb/go.mod",
+		"google.golang.org/genproto v0.0.0-20200115191322-ca5a22157cba/go.mod",
+		"google.golang.org/genproto v0.0.0-20200122232147-0452cf42e150/go.mod",
+		"google.golang.org/genproto v0.0.0-20200204135345-fa8e72b47b90/go.mod",
+		"google.golang.org/genproto v0.0.0-20200212174721-66ed5ce911ce/go.mod",
+		"google.golang.org/genproto v0.0.0-20200224152610-e50cd9704f63/go.mod",
+		"google.golang.org/genproto v0.0.0-20200228133532-8c2c7df3a383/go.mod",
+		"google.golang.org/genproto v0.0.0-20200305110556-506484158171/go.mod",
+		"google.golang.org/genproto v0.0.0-20200312145019-da6875a35672/go.mod",
+		"google.golang.org/genproto v0.0.0-20200331122359-1ee6d9798940/go.mod",
+		"google.golang.org/genproto v0.0.0-20200430143042-b979b6f78d84/go.mod",
+		"google.golang.org/genproto v0.0.0-20200511104702-f5ebc3bea380/go.mod",
+		"google.golang.org/genproto v0.0.0-20200515170657-fc4c6c6a6587/go.mod",
+		"google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013/go.mod",
+		"google.golang.org/genproto v0.0.0-20200618031413-b414f8b61790/go.mod",
+		"google.golang.org/genproto v0.0.0-20200729003335-053ba62fc06f/go.mod",
+		"google.golang.org/genproto v0.0.0-20200804131852-c06518451d9c/go.mod",
+		"google.golang.org/genproto v0.0.0-20200825200019-8632dd797987/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.20.1/go.mod",
+		"google.golang.org/grpc v1.21.1/go.mod",
+		"google.golang.org/grpc v1.23.0/go.mod",
+		"google.golang.org/grpc v1.25.1/go.mod",
+		"google.golang.org/grpc v1.26.0/go.mod",
+		"google.golang.org/grpc v1.27.0/go.mod",
+		"google.golang.org/grpc v1.27.1/go.mod",
+		"google.golang.org/grpc v1.28.0/go.mod",
+		"google.golang.org/grpc v1.29.1/go.mod",
+		"google.golang.org/grpc v1.30.0/go.mod",
+		"google.golang.org/grpc v1.31.0/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.22.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.23.1-0.20200526195155-81db48ad09cc/go.mod",
+		"google.golang.org/protobuf v1.24.0/go.mod",
+		"google.golang.org/protobuf v1.25.0/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.26.0/go.mod",
+		"gopkg.in/alecthomas/kingpin.v2 v2.2.6/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15/go.mod",
+		"gopkg.in/errgo.v2 v2.1.0/go.mod",
+		"gopkg.in/yaml.v2 v2.2.1/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.2.4/go.mod",
+		"gopkg.in/yaml.v2 v2.2.5/go.mod",
+		"gopkg.in/yaml.v2 v2.3.0/go.mod",
+		"gopkg.in/yaml.v2 v2.4.0/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+		"honnef.co/go/tools v0.0.0-20190106161140-3f1c8253044a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190418001031-e561f6794a2a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190523083050-ea95bdfd59fc/go.mod",
+		"honnef.co/go/tools v0.0.1-2019.2.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.4/go.mod",
+		"rsc.io/binaryregexp v0.2.0/go.mod",
+		"rsc.io/quote/v3 v3.1.0/go.mod",
+		"rsc.io/sampler v1.3.0/go.mod",
+	},
+	"github.com/prometheus/procfs@v0.6.0": {
+		"github.com/google/go-cmp v0.5.4/go.mod",
+		"golang.org/x/sync v0.0.0-20201207232520-09787c993a3a/go.mod",
+		"golang.org/x/sys v0.0.0-20210124154548-22da62e12c0c/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+	},
+	"github.com/prometheus/procfs@v0.7.3": {
+		"github.com/google/go-cmp v0.5.4/go.mod",
+		"golang.org/x/sync v0.0.0-20201207232520-09787c993a3a/go.mod",
+		"golang.org/x/sys v0.0.0-20210124154548-22da62e12c0c/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+	},
+	"github.com/rabbitmq/amqp091-go@v1.9.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"go.uber.org/goleak v1.2.1",
+		"go.uber.org/goleak v1.2.1/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/redis/go-redis/v9@v9.4.0": {
+		"github.com/bsm/ginkgo/v2 v2.12.0",
+		"github.com/bsm/ginkgo/v2 v2.12.0/go.mod",
+		"github.com/bsm/gomega v1.27.10",
+		"github.com/bsm/gomega v1.27.10/go.mod",
+		"github.com/cespare/xxhash/v2 v2.2.0/go.mod",
+		"github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f/go.mod",
+	},
+	"github.com/rogpeppe/go-internal@v1.8.1": {
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/pkg/diff v0.0.0-20210226163009-20ebb0f2a09e/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/errgo.v2 v2.1.0/go.mod",
+	},
+	"github.com/rs/zerolog@v1.32.0": {
+		"github.com/coreos/go-systemd/v22 v22.5.0/go.mod",
+		"github.com/godbus/dbus/v5 v5.0.4/go.mod",
+		"github.com/mattn/go-colorable v0.1.13/go.mod",
+		"github.com/mattn/go-isatty v0.0.16/go.mod",
+		"github.com/mattn/go-isatty v0.0.19/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/rs/xid v1.5.0/go.mod",
+		"golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab/go.mod",
+		"golang.org/x/sys v0.6.0/go.mod",
+		"golang.org/x/sys v0.12.0/go.mod",
+	},
+	"github.com/segmentio/kafka-go@v0.4.47": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/klauspost/compress v1.15.9/go.mod",
+		"github.com/pierrec/lz4/v4 v4.1.15/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/xdg-go/pbkdf2 v1.0.0",
+		"github.com/xdg-go/pbkdf2 v1.0.0/go.mod",
+		"github.com/xdg-go/scram v1.1.2",
+		"github.com/xdg-go/scram v1.1.2/go.mod",
+		"github.com/xdg-go/stringprep v1.0.4",
+		"github.com/xdg-go/stringprep v1.0.4/go.mod",
+		"github.com/yuin/goldmark v1.4.13/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20210921155107-089bfa567519/go.mod",
+		"golang.org/x/crypto v0.14.0/go.mod",
+		"golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4/go.mod",
+		"golang.org/x/mod v0.8.0/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20210226172049-e18ecbb05110/go.mod",
+		"golang.org/x/net v0.0.0-20220722155237-a158d28d115b/go.mod",
+		"golang.org/x/net v0.6.0/go.mod",
+		"golang.org/x/net v0.10.0/go.mod",
+		"golang.org/x/net v0.17.0",
+		"golang.org/x/net v0.17.0/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20220722155255-886fb9371eb4/go.mod",
+		"golang.org/x/sync v0.1.0/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1/go.mod",
+		"golang.org/x/sys v0.0.0-20220520151302-bc2c85ada10a/go.mod",
+		"golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f/go.mod",
+		"golang.org/x/sys v0.5.0/go.mod",
+		"golang.org/x/sys v0.8.0/go.mod",
+		"golang.org/x/sys v0.13.0/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/term v0.0.0-20210927222741-03fcf44c2211/go.mod",
+		"golang.org/x/term v0.5.0/go.mod",
+		"golang.org/x/term v0.8.0/go.mod",
+		"golang.org/x/term v0.13.0/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.7/go.mod",
+		"golang.org/x/text v0.3.8/go.mod",
+		"golang.org/x/text v0.7.0/go.mod",
+		"golang.org/x/text v0.9.0/go.mod",
+		"golang.org/x/text v0.13.0",
+		"golang.org/x/text v0.13.0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.1.12/go.mod",
+		"golang.org/x/tools v0.6.0/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/shirou/gopsutil/v3@v3.23.12": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-ole/go-ole v1.2.6/go.mod",
+		"github.com/google/go-cmp v0.5.6/go.mod",
+		"github.com/google/go-cmp v0.5.9/go.mod",
+		"github.com/google/go-cmp v0.6.0/go.mod",
+		"github.com/lufia/plan9stats v0.0.0-20211012122336-39d0f177ccd0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/power-devops/perfstat v0.0.0-20210106213030-5aafc221ea8c/go.mod",
+		"github.com/shoenig/go-m1cpu v0.1.6/go.mod",
+		"github.com/shoenig/test v0.6.4/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"github.com/tklauser/go-sysconf v0.3.12/go.mod",
+		"github.com/tklauser/numcpus v0.6.1/go.mod",
+		"github.com/yusufpapurcu/wmi v1.2.3/go.mod",
+		"golang.org/x/sys v0.0.0-20190916202348-b4ddaad3f8a3/go.mod",
+		"golang.org/x/sys v0.0.0-20201204225414-ed752295db88/go.mod",
+		"golang.org/x/sys v0.8.0/go.mod",
+		"golang.org/x/sys v0.11.0/go.mod",
+		"golang.org/x/sys v0.15.0/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/sirupsen/logrus@v1.6.0": {
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/konsorten/go-windows-terminal-sequences v1.0.3/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/testify v1.2.2/go.mod",
+		"golang.org/x/sys v0.0.0-20190422165155-953cdadca894/go.mod",
+	},
+	"github.com/sirupsen/logrus@v1.8.1": {
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/testify v1.2.2/go.mod",
+		"golang.org/x/sys v0.0.0-20191026070338-33540a1f6037/go.mod",
+	},
+	"github.com/sirupsen/logrus@v1.9.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+	},
+	"github.com/sirupsen/logrus@v1.9.2": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+	},
+	"github.com/sirupsen/logrus@v1.9.3": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.7.0",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+	},
+	"github.com/spf13/cobra@v1.6.1": {
+		"github.com/cpuguy83/go-md2man/v2 v2.0.2/go.mod",
+		"github.com/inconshreveable/mousetrap v1.0.1/go.mod",
+		"github.com/russross/blackfriday/v2 v2.1.0/go.mod",
+		"github.com/spf13/pflag v1.0.5/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/spf13/cobra@v1.8.0": {
+		"github.com/cpuguy83/go-md2man/v2 v2.0.3/go.mod",
+		"github.com/inconshreveable/mousetrap v1.1.0/go.mod",
+		"github.com/russross/blackfriday/v2 v2.1.0/go.mod",
+		"github.com/spf13/pflag v1.0.5/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/spf13/viper@v1.18.2": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc",
+		"github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc/go.mod",
+		"github.com/frankban/quicktest v1.14.6",
+		"github.com/frankban/quicktest v1.14.6/go.mod",
+		"github.com/fsnotify/fsnotify v1.7.0/go.mod",
+		"github.com/google/go-cmp v0.5.9",
+		"github.com/google/go-cmp v0.5.9/go.mod",
+		"github.com/hashicorp/hcl v1.0.0/go.mod",
+		"github.com/kr/pretty v0.3.1",
+		"github.com/kr/pretty v0.3.1/go.mod",
+		"github.com/kr/text v0.2.0",
+		"github.com/kr/text v0.2.0/go.mod",
+		"github.com/magiconair/properties v1.8.7/go.mod",
+		"github.com/mitchellh/mapstructure v1.5.0/go.mod",
+		"github.com/pelletier/go-toml/v2 v2.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2",
+		"github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2/go.mod",
+		"github.com/rogpeppe/go-internal v1.9.0",
+		"github.com/rogpeppe/go-internal v1.9.0/go.mod",
+		"github.com/sagikazarmark/locafero v0.4.0/go.mod",
+		"github.com/sagikazarmark/slog-shim v0.1.0/go.mod",
+		"github.com/sourcegraph/conc v0.3.0/go.mod",
+		"github.com/spf13/afero v1.11.0/go.mod",
+		"github.com/spf13/cast v1.6.0/go.mod",
+		"github.com/spf13/pflag v1.0.5/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.4",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"github.com/subosito/gotenv v1.6.0/go.mod",
+		"go.uber.org/atomic v1.9.0/go.mod",
+		"go.uber.org/multierr v1.9.0/go.mod",
+		"golang.org/x/exp v0.0.0-20230905200255-921286631fa9/go.mod",
+		"golang.org/x/sys v0.15.0/go.mod",
+		"golang.org/x/text v0.14.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15",
+		"gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15/go.mod",
+		"gopkg.in/ini.v1 v1.67.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/stretchr/objx@v0.4.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+	},
+	"github.com/stretchr/objx@v0.5.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/stretchr/testify@v1.8.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/stretchr/testify@v1.8.1": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/stretchr/testify@v1.8.2": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/stretchr/testify@v1.8.4": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"github.com/swaggo/files@v0.0.0-20220610200504-28940afbdbfe": {
+		"golang.org/x/net v0.0.0-20210805182204-aaa1db679c0d/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210423082822-04245dca01da/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/text v0.3.6/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+	},
+	"github.com/swaggo/http-swagger@v1.3.4": {
+		"github.com/KyleBanks/depth v1.2.1/go.mod",
+		"github.com/agiledragon/gomonkey/v2 v2.3.1",
+		"github.com/agiledragon/gomonkey/v2 v2.3.1/go.mod",
+		"github.com/creack/pty v1.1.9/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-openapi/jsonpointer v0.19.3/go.mod",
+		"github.com/go-openapi/jsonpointer v0.19.5/go.mod",
+		"github.com/go-openapi/jsonreference v0.20.0/go.mod",
+		"github.com/go-openapi/spec v0.20.6/go.mod",
+		"github.com/go-openapi/swag v0.19.5/go.mod",
+		"github.com/go-openapi/swag v0.19.15/go.mod",
+		"github.com/josharian/intern v1.0.0/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/kr/text v0.2.0",
+		"github.com/kr/text v0.2.0/go.mod",
+		"github.com/mailru/easyjson v0.0.0-20190614124828-94de47d64c63/go.mod",
+		"github.com/mailru/easyjson v0.0.0-20190626092158-b2ccc519800e/go.mod",
+		"github.com/mailru/easyjson v0.7.6/go.mod",
+		"github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e",
+		"github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e/go.mod",
+		"github.com/otiai10/copy v1.7.0",
+		"github.com/otiai10/copy v1.7.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+		"github.com/stretchr/testify v1.6.1/go.mod",
+		"github.com/stretchr/testify v1.7.0",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"github.com/swaggo/files v0.0.0-20220610200504-28940afbdbfe/go.mod",
+		"github.com/swaggo/swag v1.8.1/go.mod",
+		"golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4",
+		"golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4/go.mod",
+		"golang.org/x/net v0.0.0-20210805182204-aaa1db679c0d/go.mod",
+		"golang.org/x/net v0.7.0/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210423082822-04245dca01da/go.mod",
+		"golang.org/x/sys v0.5.0/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/text v0.3.6/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.1.12/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f",
+		"gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"gopkg.in/yaml.v2 v2.4.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776",
+		"gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776/go.mod",
+	},
+	"github.com/testcontainers/testcontainers-go@v0.28.0": {
+		"dario.cat/mergo v1.0.0/go.mod",
+		"github.com/AdaLogics/go-fuzz-headers v0.0.0-20230811130428-ced1acdcaa24",
+		"github.com/AdaLogics/go-fuzz-headers v0.0.0-20230811130428-ced1acdcaa24/go.mod",
+		"github.com/Azure/go-ansiterm v0.0.0-20210617225240-d185dfc1b5a1/go.mod",
+		"github.com/Microsoft/go-winio v0.6.1/go.mod",
+		"github.com/Microsoft/hcsshim v0.11.4/go.mod",
+		"github.com/cenkalti/backoff/v4 v4.2.1/go.mod",
+		"github.com/cespare/xxhash/v2 v2.2.0",
+		"github.com/cespare/xxhash/v2 v2.2.0/go.mod",
+		"github.com/containerd/containerd v1.7.12/go.mod",
+		"github.com/containerd/log v0.1.0/go.mod",
+		"github.com/cpuguy83/dockercfg v0.3.1/go.mod",
+		"github.com/creack/pty v1.1.9/go.mod",
+		"github.com/creack/pty v1.1.18",
+		"github.com/creack/pty v1.1.18/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/distribution/reference v0.5.0/go.mod",
+		"github.com/docker/docker v25.0.2+incompatible/go.mod",
+		"github.com/docker/go-connections v0.5.0/go.mod",
+		"github.com/docker/go-units v0.5.0/go.mod",
+		"github.com/felixge/httpsnoop v1.0.3/go.mod",
+		"github.com/go-logr/logr v1.2.2/go.mod",
+		"github.com/go-logr/logr v1.2.4/go.mod",
+		"github.com/go-logr/stdr v1.2.2/go.mod",
+		"github.com/go-ole/go-ole v1.2.6/go.mod",
+		"github.com/gogo/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.5.3/go.mod",
+		"github.com/google/go-cmp v0.5.9",
+		"github.com/google/go-cmp v0.5.9/go.mod",
+		"github.com/google/uuid v1.6.0/go.mod",
+		"github.com/grpc-ecosystem/grpc-gateway/v2 v2.16.0",
+		"github.com/grpc-ecosystem/grpc-gateway/v2 v2.16.0/go.mod",
+		"github.com/kisielk/errcheck v1.5.0/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/klauspost/compress v1.16.0/go.mod",
+		"github.com/kr/pretty v0.3.0/go.mod",
+		"github.com/kr/text v0.2.0/go.mod",
+		"github.com/lufia/plan9stats v0.0.0-20211012122336-39d0f177ccd0/go.mod",
+		"github.com/magiconair/properties v1.8.7/go.mod",
+		"github.com/moby/patternmatcher v0.6.0/go.mod",
+		"github.com/moby/sys/sequential v0.5.0/go.mod",
+		"github.com/moby/sys/user v0.1.0/go.mod",
+		"github.com/moby/term v0.5.0/go.mod",
+		"github.com/morikuni/aec v1.0.0/go.mod",
+		"github.com/opencontainers/go-digest v1.0.0/go.mod",
+		"github.com/opencontainers/image-spec v1.1.0-rc5/go.mod",
+		"github.com/pkg/errors v0.9.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/power-devops/perfstat v0.0.0-20210106213030-5aafc221ea8c/go.mod",
+		"github.com/rogpeppe/go-internal v1.8.1/go.mod",
+		"github.com/shirou/gopsutil/v3 v3.23.12/go.mod",
+		"github.com/shoenig/go-m1cpu v0.1.6/go.mod",
+		"github.com/sirupsen/logrus v1.9.3/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.7.0/go.mod",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"github.com/tklauser/go-sysconf v0.3.12/go.mod",
+		"github.com/tklauser/numcpus v0.6.1/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.2.1/go.mod",
+		"github.com/yusufpapurcu/wmi v1.2.3/go.mod",
+		"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.45.0/go.mod",
+		"go.opentelemetry.io/otel v1.19.0/go.mod",
+		"go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.19.0",
+		"go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.19.0/go.mod",
+		"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.19.0",
+		"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.19.0/go.mod",
+		"go.opentelemetry.io/otel/metric v1.19.0/go.mod",
+		"go.opentelemetry.io/otel/sdk v1.19.0",
+		"go.opentelemetry.io/otel/sdk v1.19.0/go.mod",
+		"go.opentelemetry.io/otel/trace v1.19.0/go.mod",
+		"go.opentelemetry.io/proto/otlp v1.0.0",
+		"go.opentelemetry.io/proto/otlp v1.0.0/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20230510235704-dd950f8aeaea/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/mod v0.11.0/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20201021035429-f5854403a974/go.mod",
+		"golang.org/x/net v0.17.0",
+		"golang.org/x/net v0.17.0/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20201020160332-67f06af15bc9/go.mod",
+		"golang.org/x/sync v0.3.0",
+		"golang.org/x/sync v0.3.0/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20200930185726-fdedc70b468f/go.mod",
+		"golang.org/x/sys v0.0.0-20210616094352-59db8d763f22/go.mod",
+		"golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8/go.mod",
+		"golang.org/x/sys v0.16.0/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.13.0",
+		"golang.org/x/text v0.13.0/go.mod",
+		"golang.org/x/time v0.3.0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20200619180055-7c47624df98f/go.mod",
+		"golang.org/x/tools v0.0.0-20210106214847-113979e3529a/go.mod",
+		"golang.org/x/tools v0.10.0/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/genproto v0.0.0-20230711160842-782d3b101e98",
+		"google.golang.org/genproto/googleapis/api v0.0.0-20230711160842-782d3b101e98",
+		"google.golang.org/genproto/googleapis/api v0.0.0-20230711160842-782d3b101e98/go.mod",
+		"google.golang.org/genproto/googleapis/rpc v0.0.0-20230711160842-782d3b101e98/go.mod",
+		"google.golang.org/grpc v1.58.3/go.mod",
+		"google.golang.org/protobuf v1.31.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+		"gotest.tools/v3 v3.5.0/go.mod",
+	},
+	"github.com/tklauser/go-sysconf@v0.3.12": {
+		"github.com/tklauser/numcpus v0.6.1/go.mod",
+		"golang.org/x/sys v0.8.0/go.mod",
+		"golang.org/x/sys v0.11.0/go.mod",
+	},
+	"github.com/tklauser/numcpus@v0.6.1": {
+		"golang.org/x/sys v0.8.0/go.mod",
+	},
+	"github.com/twmb/franz-go@v1.15.4": {
+		"github.com/klauspost/compress v1.16.7/go.mod",
+		"github.com/pierrec/lz4/v4 v4.1.19/go.mod",
+		"github.com/twmb/franz-go/pkg/kmsg v1.7.0/go.mod",
+		"golang.org/x/crypto v0.17.0/go.mod",
+		"golang.org/x/net v0.10.0/go.mod",
+		"golang.org/x/sys v0.15.0/go.mod",
+		"golang.org/x/term v0.15.0/go.mod",
+		"golang.org/x/text v0.14.0/go.mod",
+	},
+	"github.com/urfave/cli/v2@v2.27.1": {
+		"github.com/cpuguy83/go-md2man/v2 v2.0.2/go.mod",
+		"github.com/russross/blackfriday/v2 v2.1.0/go.mod",
+		"github.com/xrash/smetrics v0.0.0-20201216005158-039620a65673/go.mod",
+	},
+	"github.com/valyala/fasttemplate@v1.2.2": {
+		"github.com/valyala/bytebufferpool v1.0.0/go.mod",
+	},
+	"github.com/vektah/gqlparser/v2@v2.5.11": {
+		"github.com/agnivade/levenshtein v1.1.1/go.mod",
+		"github.com/andreyvit/diff v0.0.0-20170406064948-c7f18ee00883",
+		"github.com/andreyvit/diff v0.0.0-20170406064948-c7f18ee00883/go.mod",
+		"github.com/arbovm/levenshtein v0.0.0-20160628152529-48b4e1c0c4d0",
+		"github.com/arbovm/levenshtein v0.0.0-20160628152529-48b4e1c0c4d0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/dgryski/trifles v0.0.0-20200323201526-dd97f9abfb48",
+		"github.com/dgryski/trifles v0.0.0-20200323201526-dd97f9abfb48/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/sergi/go-diff v1.3.1",
+		"github.com/sergi/go-diff v1.3.1/go.mod",
+		"github.com/stretchr/testify v1.4.0",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.4.0",
+		"gopkg.in/yaml.v2 v2.4.0/go.mod",
+	},
+	"github.com/xdg-go/scram@v1.1.2": {
+		"github.com/xdg-go/pbkdf2 v1.0.0/go.mod",
+		"github.com/xdg-go/stringprep v1.0.4/go.mod",
+		"github.com/yuin/goldmark v1.4.13/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20210921155107-089bfa567519/go.mod",
+		"golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20210226172049-e18ecbb05110/go.mod",
+		"golang.org/x/net v0.0.0-20220722155237-a158d28d115b/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20220722155255-886fb9371eb4/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1/go.mod",
+		"golang.org/x/sys v0.0.0-20220520151302-bc2c85ada10a/go.mod",
+		"golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/term v0.0.0-20210927222741-03fcf44c2211/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.7/go.mod",
+		"golang.org/x/text v0.3.8/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.1.12/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+	},
+	"github.com/xdg-go/stringprep@v1.0.4": {
+		"github.com/yuin/goldmark v1.4.13/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20210921155107-089bfa567519/go.mod",
+		"golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20210226172049-e18ecbb05110/go.mod",
+		"golang.org/x/net v0.0.0-20220722155237-a158d28d115b/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20220722155255-886fb9371eb4/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1/go.mod",
+		"golang.org/x/sys v0.0.0-20220520151302-bc2c85ada10a/go.mod",
+		"golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/term v0.0.0-20210927222741-03fcf44c2211/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.7/go.mod",
+		"golang.org/x/text v0.3.8/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.1.12/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+	},
+	"github.com/yusufpapurcu/wmi@v1.2.3": {
+		"github.com/go-ole/go-ole v1.2.6/go.mod",
+		"golang.org/x/sys v0.0.0-20190916202348-b4ddaad3f8a3/go.mod",
+	},
+	"go.mongodb.org/mongo-driver@v1.13.1": {
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/golang/snappy v0.0.1/go.mod",
+		"github.com/google/go-cmp v0.5.2/go.mod",
+		"github.com/klauspost/compress v1.13.6/go.mod",
+		"github.com/montanaflynn/stats v0.0.0-20171201202039-1bf9dbcd8cbe/go.mod",
+		"github.com/xdg-go/pbkdf2 v1.0.0/go.mod",
+		"github.com/xdg-go/scram v1.1.2/go.mod",
+		"github.com/xdg-go/stringprep v1.0.4/go.mod",
+		"github.com/youmark/pkcs8 v0.0.0-20181117223130-1be2e3e5546d/go.mod",
+		"github.com/yuin/goldmark v1.4.13/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20210921155107-089bfa567519/go.mod",
+		"golang.org/x/crypto v0.0.0-20220622213112-05595931fe9d/go.mod",
+		"golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20210226172049-e18ecbb05110/go.mod",
+		"golang.org/x/net v0.0.0-20211112202133-69e39bad7dc2/go.mod",
+		"golang.org/x/net v0.0.0-20220722155237-a158d28d115b/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20220722155255-886fb9371eb4/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210423082822-04245dca01da/go.mod",
+		"golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1/go.mod",
+		"golang.org/x/sys v0.0.0-20220520151302-bc2c85ada10a/go.mod",
+		"golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/term v0.0.0-20210927222741-03fcf44c2211/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.6/go.mod",
+		"golang.org/x/text v0.3.7/go.mod",
+		"golang.org/x/text v0.3.8/go.mod",
+		"golang.org/x/text v0.7.0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.1.12/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+	},
+	"go.opencensus.io@v0.22.5": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20190227155943-e225da77a7e6/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190502145724-3ef323f4f1fd/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190425155659-357c62f0e4bb/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.20.1/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+	},
+	"go.opencensus.io@v0.23.0": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/census-instrumentation/opencensus-proto v0.2.1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/cncf/udpa/go v0.0.0-20191209042840-269d4d468f6f/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.1-0.20191026205805-5f8ba28d4473/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.4/go.mod",
+		"github.com/envoyproxy/protoc-gen-validate v0.1.0/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.1/go.mod",
+		"github.com/golang/protobuf v1.4.3/go.mod",
+		"github.com/google/go-cmp v0.2.0/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.3/go.mod",
+		"github.com/google/uuid v1.1.2/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.6.1/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20201110031124-69a78807bb2b/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20200930185726-fdedc70b468f/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20190524140312-2c0ae7006135/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55/go.mod",
+		"google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.23.0/go.mod",
+		"google.golang.org/grpc v1.25.1/go.mod",
+		"google.golang.org/grpc v1.27.0/go.mod",
+		"google.golang.org/grpc v1.33.2/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.22.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.23.1-0.20200526195155-81db48ad09cc/go.mod",
+		"google.golang.org/protobuf v1.25.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+		"honnef.co/go/tools v0.0.0-20190523083050-ea95bdfd59fc/go.mod",
+	},
+	"go.opencensus.io@v0.24.0": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/census-instrumentation/opencensus-proto v0.2.1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/cncf/udpa/go v0.0.0-20191209042840-269d4d468f6f/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.1-0.20191026205805-5f8ba28d4473/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.4/go.mod",
+		"github.com/envoyproxy/protoc-gen-validate v0.1.0/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.1/go.mod",
+		"github.com/golang/protobuf v1.4.3/go.mod",
+		"github.com/google/go-cmp v0.2.0/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.3/go.mod",
+		"github.com/google/uuid v1.1.2/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/objx v0.4.0/go.mod",
+		"github.com/stretchr/objx v0.5.0/go.mod",
+		"github.com/stretchr/testify v1.7.1/go.mod",
+		"github.com/stretchr/testify v1.8.0/go.mod",
+		"github.com/stretchr/testify v1.8.1/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20201110031124-69a78807bb2b/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20200930185726-fdedc70b468f/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20190524140312-2c0ae7006135/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55/go.mod",
+		"google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.23.0/go.mod",
+		"google.golang.org/grpc v1.25.1/go.mod",
+		"google.golang.org/grpc v1.27.0/go.mod",
+		"google.golang.org/grpc v1.33.2/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.22.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.23.1-0.20200526195155-81db48ad09cc/go.mod",
+		"google.golang.org/protobuf v1.25.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+		"honnef.co/go/tools v0.0.0-20190523083050-ea95bdfd59fc/go.mod",
+	},
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp@v0.47.0": {
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/felixge/httpsnoop v1.0.4/go.mod",
+		"github.com/go-logr/logr v1.2.2/go.mod",
+		"github.com/go-logr/logr v1.4.1/go.mod",
+		"github.com/go-logr/stdr v1.2.2/go.mod",
+		"github.com/google/go-cmp v0.6.0",
+		"github.com/google/go-cmp v0.6.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/testify v1.8.4",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"go.opentelemetry.io/otel v1.22.0/go.mod",
+		"go.opentelemetry.io/otel/metric v1.22.0/go.mod",
+		"go.opentelemetry.io/otel/trace v1.22.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"go.opentelemetry.io/otel@v1.22.0": {
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-logr/logr v1.2.2/go.mod",
+		"github.com/go-logr/logr v1.4.1/go.mod",
+		"github.com/go-logr/stdr v1.2.2/go.mod",
+		"github.com/google/go-cmp v0.6.0",
+		"github.com/google/go-cmp v0.6.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/testify v1.8.4",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"go.opentelemetry.io/otel/metric v1.22.0/go.mod",
+		"go.opentelemetry.io/otel/trace v1.22.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp@v1.22.0": {
+		"github.com/cenkalti/backoff/v4 v4.2.1/go.mod",
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-logr/logr v1.2.2/go.mod",
+		"github.com/go-logr/logr v1.4.1/go.mod",
+		"github.com/go-logr/stdr v1.2.2/go.mod",
+		"github.com/golang/glog v1.1.2",
+		"github.com/golang/glog v1.1.2/go.mod",
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/golang/protobuf v1.5.3/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/google/go-cmp v0.6.0",
+		"github.com/google/go-cmp v0.6.0/go.mod",
+		"github.com/grpc-ecosystem/grpc-gateway/v2 v2.16.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/testify v1.8.4",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"go.opentelemetry.io/otel v1.22.0/go.mod",
+		"go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.22.0/go.mod",
+		"go.opentelemetry.io/otel/metric v1.22.0/go.mod",
+		"go.opentelemetry.io/otel/sdk v1.22.0/go.mod",
+		"go.opentelemetry.io/otel/trace v1.22.0/go.mod",
+		"go.opentelemetry.io/proto/otlp v1.0.0/go.mod",
+		"golang.org/x/net v0.17.0/go.mod",
+		"golang.org/x/sys v0.16.0/go.mod",
+		"golang.org/x/text v0.13.0/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/genproto v0.0.0-20231002182017-d307bd883b97",
+		"google.golang.org/genproto v0.0.0-20231002182017-d307bd883b97/go.mod",
+		"google.golang.org/genproto/googleapis/api v0.0.0-20231002182017-d307bd883b97/go.mod",
+		"google.golang.org/genproto/googleapis/rpc v0.0.0-20231002182017-d307bd883b97/go.mod",
+		"google.golang.org/grpc v1.60.1/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.26.0/go.mod",
+		"google.golang.org/protobuf v1.32.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"go.opentelemetry.io/otel/sdk@v1.22.0": {
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/go-logr/logr v1.2.2/go.mod",
+		"github.com/go-logr/logr v1.4.1/go.mod",
+		"github.com/go-logr/stdr v1.2.2/go.mod",
+		"github.com/google/go-cmp v0.6.0",
+		"github.com/google/go-cmp v0.6.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/testify v1.8.4",
+		"github.com/stretchr/testify v1.8.4/go.mod",
+		"go.opentelemetry.io/otel v1.22.0/go.mod",
+		"go.opentelemetry.io/otel/metric v1.22.0/go.mod",
+		"go.opentelemetry.io/otel/trace v1.22.0/go.mod",
+		"golang.org/x/sys v0.16.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"go.uber.org/atomic@v1.7.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+	},
+	"go.uber.org/atomic@v1.9.0": {
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.3.0/go.mod",
+	},
+	"go.uber.org/mock@v0.4.0": {},
+	"go.uber.org/zap@v1.26.0": {
+		"github.com/davecgh/go-spew v1.1.1",
+		"github.com/davecgh/go-spew v1.1.1/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/stretchr/testify v1.8.1",
+		"github.com/stretchr/testify v1.8.1/go.mod",
+		"go.uber.org/goleak v1.2.0",
+		"go.uber.org/goleak v1.2.0/go.mod",
+		"go.uber.org/multierr v1.10.0/go.mod",
+		"gopkg.in/yaml.v3 v3.0.1",
+		"gopkg.in/yaml.v3 v3.0.1/go.mod",
+	},
+	"golang.org/x/crypto@v0.18.0": {},
+	"golang.org/x/oauth2@v0.0.0-20210402161424-2e8d93401602": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"cloud.google.com/go v0.34.0/go.mod",
+		"cloud.google.com/go v0.38.0/go.mod",
+		"cloud.google.com/go v0.44.1/go.mod",
+		"cloud.google.com/go v0.44.2/go.mod",
+		"cloud.google.com/go v0.45.1/go.mod",
+		"cloud.google.com/go v0.46.3/go.mod",
+		"cloud.google.com/go v0.50.0/go.mod",
+		"cloud.google.com/go v0.52.0/go.mod",
+		"cloud.google.com/go v0.53.0/go.mod",
+		"cloud.google.com/go v0.54.0/go.mod",
+		"cloud.google.com/go v0.56.0/go.mod",
+		"cloud.google.com/go v0.57.0/go.mod",
+		"cloud.google.com/go v0.62.0/go.mod",
+		"cloud.google.com/go v0.65.0/go.mod",
+		"cloud.google.com/go/bigquery v1.0.1/go.mod",
+		"cloud.google.com/go/bigquery v1.3.0/go.mod",
+		"cloud.google.com/go/bigquery v1.4.0/go.mod",
+		"cloud.google.com/go/bigquery v1.5.0/go.mod",
+		"cloud.google.com/go/bigquery v1.7.0/go.mod",
+		"cloud.google.com/go/bigquery v1.8.0/go.mod",
+		"cloud.google.com/go/datastore v1.0.0/go.mod",
+		"cloud.google.com/go/datastore v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.0.1/go.mod",
+		"cloud.google.com/go/pubsub v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.2.0/go.mod",
+		"cloud.google.com/go/pubsub v1.3.1/go.mod",
+		"cloud.google.com/go/storage v1.0.0/go.mod",
+		"cloud.google.com/go/storage v1.5.0/go.mod",
+		"cloud.google.com/go/storage v1.6.0/go.mod",
+		"cloud.google.com/go/storage v1.8.0/go.mod",
+		"cloud.google.com/go/storage v1.10.0/go.mod",
+		"dmitri.shuralyov.com/gpu/mtl v0.0.0-20190408044501-666a987793e9/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/BurntSushi/xgb v0.0.0-20160522181843-27f122750802/go.mod",
+		"github.com/census-instrumentation/opencensus-proto v0.2.1/go.mod",
+		"github.com/chzyer/logex v1.1.10/go.mod",
+		"github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e/go.mod",
+		"github.com/chzyer/test v0.0.0-20180213035817-a1ea475d72b1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/cncf/udpa/go v0.0.0-20191209042840-269d4d468f6f/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.1-0.20191026205805-5f8ba28d4473/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.4/go.mod",
+		"github.com/envoyproxy/protoc-gen-validate v0.1.0/go.mod",
+		"github.com/go-gl/glfw v0.0.0-20190409004039-e6da0acd62b1/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20191125211704-12ad95a8df72/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20200222043503-6f7a984d4dc4/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6/go.mod",
+		"github.com/golang/groupcache v0.0.0-20191227052852-215e87163ea7/go.mod",
+		"github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/mock v1.2.0/go.mod",
+		"github.com/golang/mock v1.3.1/go.mod",
+		"github.com/golang/mock v1.4.0/go.mod",
+		"github.com/golang/mock v1.4.1/go.mod",
+		"github.com/golang/mock v1.4.3/go.mod",
+		"github.com/golang/mock v1.4.4/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.3.3/go.mod",
+		"github.com/golang/protobuf v1.3.4/go.mod",
+		"github.com/golang/protobuf v1.3.5/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.1/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/google/btree v0.0.0-20180813153112-4030bb1f1f0c/go.mod",
+		"github.com/google/btree v1.0.0/go.mod",
+		"github.com/google/go-cmp v0.2.0/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.4.1/go.mod",
+		"github.com/google/go-cmp v0.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.1/go.mod",
+		"github.com/google/martian v2.1.0+incompatible/go.mod",
+		"github.com/google/martian/v3 v3.0.0/go.mod",
+		"github.com/google/pprof v0.0.0-20181206194817-3ea8567a2e57/go.mod",
+		"github.com/google/pprof v0.0.0-20190515194954-54271f7e092f/go.mod",
+		"github.com/google/pprof v0.0.0-20191218002539-d4f498aebedc/go.mod",
+		"github.com/google/pprof v0.0.0-20200212024743-f11f1df84d12/go.mod",
+		"github.com/google/pprof v0.0.0-20200229191704-1ebb73c60ed3/go.mod",
+		"github.com/google/pprof v0.0.0-20200430221834-fc25d7d30c6d/go.mod",
+		"github.com/google/pprof v0.0.0-20200708004538-1a94d8640e99/go.mod",
+		"github.com/google/renameio v0.1.0/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.4/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.5/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.0/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.1/go.mod",
+		"github.com/ianlancetaylor/demangle v0.0.0-20181102032728-5e5cf60278f6/go.mod",
+		"github.com/jstemmer/go-junit-report v0.0.0-20190106144839-af01ea7f8024/go.mod",
+		"github.com/jstemmer/go-junit-report v0.9.1/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4/go.mod",
+		"github.com/rogpeppe/go-internal v1.3.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/yuin/goldmark v1.1.25/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.1.32/go.mod",
+		"go.opencensus.io v0.21.0/go.mod",
+		"go.opencensus.io v0.22.0/go.mod",
+		"go.opencensus.io v0.22.2/go.mod",
+		"go.opencensus.io v0.22.3/go.mod",
+		"go.opencensus.io v0.22.4/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20190510104115-cbcb75029529/go.mod",
+		"golang.org/x/crypto v0.0.0-20190605123033-f99c8df09eb5/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/exp v0.0.0-20190306152737-a1d7652674e8/go.mod",
+		"golang.org/x/exp v0.0.0-20190510132918-efd6b22b2522/go.mod",
+		"golang.org/x/exp v0.0.0-20190829153037-c13cbed26979/go.mod",
+		"golang.org/x/exp v0.0.0-20191030013958-a1ab85dbe136/go.mod",
+		"golang.org/x/exp v0.0.0-20191129062945-2f5052295587/go.mod",
+		"golang.org/x/exp v0.0.0-20191227195350-da58074b4299/go.mod",
+		"golang.org/x/exp v0.0.0-20200119233911-0405dc783f0a/go.mod",
+		"golang.org/x/exp v0.0.0-20200207192155-f17229e696bd/go.mod",
+		"golang.org/x/exp v0.0.0-20200224162631-6cc2880d07d6/go.mod",
+		"golang.org/x/image v0.0.0-20190227222117-0694c2d4d067/go.mod",
+		"golang.org/x/image v0.0.0-20190802002840-cff245a6509b/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190301231843-5614ed5bae6f/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/lint v0.0.0-20190409202823-959b441ac422/go.mod",
+		"golang.org/x/lint v0.0.0-20190909230951-414d861bb4ac/go.mod",
+		"golang.org/x/lint v0.0.0-20190930215403-16217165b5de/go.mod",
+		"golang.org/x/lint v0.0.0-20191125180803-fdd1cda4f05f/go.mod",
+		"golang.org/x/lint v0.0.0-20200130185559-910be7a94367/go.mod",
+		"golang.org/x/lint v0.0.0-20200302205851-738671d3881b/go.mod",
+		"golang.org/x/mobile v0.0.0-20190312151609-d3739f865fa6/go.mod",
+		"golang.org/x/mobile v0.0.0-20190719004257-d2bd2a29d028/go.mod",
+		"golang.org/x/mod v0.0.0-20190513183733-4bf6d317e70e/go.mod",
+		"golang.org/x/mod v0.1.0/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191105210325-c90efee705ee/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191107180719-034126e5016b/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190501004415-9ce7a6920f09/go.mod",
+		"golang.org/x/net v0.0.0-20190503192946-f4e77d36d62c/go.mod",
+		"golang.org/x/net v0.0.0-20190603091049-60506f45cf65/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20190628185345-da137c7871d7/go.mod",
+		"golang.org/x/net v0.0.0-20190724013045-ca1201d0de80/go.mod",
+		"golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/net v0.0.0-20200202094626-16171245cfb2/go.mod",
+		"golang.org/x/net v0.0.0-20200222125558-5a598a2470a0/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20200301022130-244492dfa37a/go.mod",
+		"golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e/go.mod",
+		"golang.org/x/net v0.0.0-20200501053045-e0ff5e5a1de5/go.mod",
+		"golang.org/x/net v0.0.0-20200506145744-7e3656a0809f/go.mod",
+		"golang.org/x/net v0.0.0-20200513185701-a91f0712d120/go.mod",
+		"golang.org/x/net v0.0.0-20200520182314-0ba52f642ac2/go.mod",
+		"golang.org/x/net v0.0.0-20200625001655-4c5254603344/go.mod",
+		"golang.org/x/net v0.0.0-20200707034311-ab3426394381/go.mod",
+		"golang.org/x/net v0.0.0-20200822124328-c89045814202/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190226205417-e64efc72b421/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20191202225959-858c2ad4c8b6/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+		"golang.org/x/sync v0.0.0-20190227155943-e225da77a7e6/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20200317015054-43a5402ce75a/go.mod",
+		"golang.org/x/sync v0.0.0-20200625203802-6e8e738ad208/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190312061237-fead79001313/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190502145724-3ef323f4f1fd/go.mod",
+		"golang.org/x/sys v0.0.0-20190507160741-ecd444e8653b/go.mod",
+		"golang.org/x/sys v0.0.0-20190606165138-5da285871e9c/go.mod",
+		"golang.org/x/sys v0.0.0-20190624142023-c5567b49c5d0/go.mod",
+		"golang.org/x/sys v0.0.0-20190726091711-fc99dfbffb4e/go.mod",
+		"golang.org/x/sys v0.0.0-20191001151750-bb3f8db39f24/go.mod",
+		"golang.org/x/sys v0.0.0-20191204072324-ce4227a45e2e/go.mod",
+		"golang.org/x/sys v0.0.0-20191228213918-04cbcbbfeed8/go.mod",
+		"golang.org/x/sys v0.0.0-20200113162924-86b910548bc1/go.mod",
+		"golang.org/x/sys v0.0.0-20200122134326-e047566fdf82/go.mod",
+		"golang.org/x/sys v0.0.0-20200202164722-d101bd2416d5/go.mod",
+		"golang.org/x/sys v0.0.0-20200212091648-12a6c2dcc1e4/go.mod",
+		"golang.org/x/sys v0.0.0-20200223170610-d5e6a3e2c0ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200302150141-5c8b2ff67527/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200331124033-c3d80250170d/go.mod",
+		"golang.org/x/sys v0.0.0-20200501052902-10377860bb8e/go.mod",
+		"golang.org/x/sys v0.0.0-20200511232937-7e40ca221e25/go.mod",
+		"golang.org/x/sys v0.0.0-20200515095857-1151b9dac4a9/go.mod",
+		"golang.org/x/sys v0.0.0-20200523222454-059865788121/go.mod",
+		"golang.org/x/sys v0.0.0-20200803210538-64077c9b5642/go.mod",
+		"golang.org/x/text v0.0.0-20170915032832-14c0d48ead0c/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.1-0.20180807135948-17ff2d5776d2/go.mod",
+		"golang.org/x/text v0.3.2/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/time v0.0.0-20181108054448-85acf8d2951c/go.mod",
+		"golang.org/x/time v0.0.0-20190308202827-9d24e82272b4/go.mod",
+		"golang.org/x/time v0.0.0-20191024005414-555d28b269f0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20190312151545-0bb0c0a6e846/go.mod",
+		"golang.org/x/tools v0.0.0-20190312170243-e65039ee4138/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+		"golang.org/x/tools v0.0.0-20190506145303-2d16b83fe98c/go.mod",
+		"golang.org/x/tools v0.0.0-20190524140312-2c0ae7006135/go.mod",
+		"golang.org/x/tools v0.0.0-20190606124116-d0a3d012864b/go.mod",
+		"golang.org/x/tools v0.0.0-20190621195816-6e04913cbbac/go.mod",
+		"golang.org/x/tools v0.0.0-20190628153133-6cdbf07be9d0/go.mod",
+		"golang.org/x/tools v0.0.0-20190816200558-6889da9d5479/go.mod",
+		"golang.org/x/tools v0.0.0-20190911174233-4f2ddba30aff/go.mod",
+		"golang.org/x/tools v0.0.0-20191012152004-8de300cfc20a/go.mod",
+		"golang.org/x/tools v0.0.0-20191113191852-77e3bb0ad9e7/go.mod",
+		"golang.org/x/tools v0.0.0-20191115202509-3a792d9c32b2/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20191125144606-a911d9008d1f/go.mod",
+		"golang.org/x/tools v0.0.0-20191130070609-6e064ea0cf2d/go.mod",
+		"golang.org/x/tools v0.0.0-20191216173652-a0e659d51361/go.mod",
+		"golang.org/x/tools v0.0.0-20191227053925-7b8e75db28f4/go.mod",
+		"golang.org/x/tools v0.0.0-20200117161641-43d50277825c/go.mod",
+		"golang.org/x/tools v0.0.0-20200122220014-bf1340f18c4a/go.mod",
+		"golang.org/x/tools v0.0.0-20200130002326-2f3ba24bd6e7/go.mod",
+		"golang.org/x/tools v0.0.0-20200204074204-1cc6d1ef6c74/go.mod",
+		"golang.org/x/tools v0.0.0-20200207183749-b753a1ba74fa/go.mod",
+		"golang.org/x/tools v0.0.0-20200212150539-ea181f53ac56/go.mod",
+		"golang.org/x/tools v0.0.0-20200224181240-023911ca70b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200227222343-706bc42d1f0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200304193943-95d2e580d8eb/go.mod",
+		"golang.org/x/tools v0.0.0-20200312045724-11d5b4c81c7d/go.mod",
+		"golang.org/x/tools v0.0.0-20200331025713-a30bf2db82d4/go.mod",
+		"golang.org/x/tools v0.0.0-20200501065659-ab2804fb9c9d/go.mod",
+		"golang.org/x/tools v0.0.0-20200512131952-2bc93b1c0c88/go.mod",
+		"golang.org/x/tools v0.0.0-20200515010526-7d3b6ebf133d/go.mod",
+		"golang.org/x/tools v0.0.0-20200618134242-20370b0cb4b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200729194436-6467de6f59a7/go.mod",
+		"golang.org/x/tools v0.0.0-20200804011535-6c149bb5ef0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200825202427-b303f430e36d/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/api v0.4.0/go.mod",
+		"google.golang.org/api v0.7.0/go.mod",
+		"google.golang.org/api v0.8.0/go.mod",
+		"google.golang.org/api v0.9.0/go.mod",
+		"google.golang.org/api v0.13.0/go.mod",
+		"google.golang.org/api v0.14.0/go.mod",
+		"google.golang.org/api v0.15.0/go.mod",
+		"google.golang.org/api v0.17.0/go.mod",
+		"google.golang.org/api v0.18.0/go.mod",
+		"google.golang.org/api v0.19.0/go.mod",
+		"google.golang.org/api v0.20.0/go.mod",
+		"google.golang.org/api v0.22.0/go.mod",
+		"google.golang.org/api v0.24.0/go.mod",
+		"google.golang.org/api v0.28.0/go.mod",
+		"google.golang.org/api v0.29.0/go.mod",
+		"google.golang.org/api v0.30.0/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/appengine v1.5.0/go.mod",
+		"google.golang.org/appengine v1.6.1/go.mod",
+		"google.golang.org/appengine v1.6.5/go.mod",
+		"google.golang.org/appengine v1.6.6/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190307195333-5fe7a883aa19/go.mod",
+		"google.golang.org/genproto v0.0.0-20190418145605-e7d98fc518a7/go.mod",
+		"google.golang.org/genproto v0.0.0-20190425155659-357c62f0e4bb/go.mod",
+		"google.golang.org/genproto v0.0.0-20190502173448-54afdca5d873/go.mod",
+		"google.golang.org/genproto v0.0.0-20190801165951-fa694d86fc64/go.mod",
+		"google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55/go.mod",
+		"google.golang.org/genproto v0.0.0-20190911173649-1774047e7e51/go.mod",
+		"google.golang.org/genproto v0.0.0-20191108220845-16a3f7862a1a/go.mod",
+		"google.golang.org/genproto v0.0.0-20191115194625-c23dd37a84c9/go.mod",
+		"google.golang.org/genproto v0.0.0-20191216164720-4f79533eabd1/go.mod",
+		"google.golang.org/genproto v0.0.0-20191230161307-f3c370f40bfb/go.mod",
+		"google.golang.org/genproto v0.0.0-20200115191322-ca5a22157cba/go.mod",
+		"google.golang.org/genproto v0.0.0-20200122232147-0452cf42e150/go.mod",
+		"google.golang.org/genproto v0.0.0-20200204135345-fa8e72b47b90/go.mod",
+		"google.golang.org/genproto v0.0.0-20200212174721-66ed5ce911ce/go.mod",
+		"google.golang.org/genproto v0.0.0-20200224152610-e50cd9704f63/go.mod",
+		"google.golang.org/genproto v0.0.0-20200228133532-8c2c7df3a383/go.mod",
+		"google.golang.org/genproto v0.0.0-20200305110556-506484158171/go.mod",
+		"google.golang.org/genproto v0.0.0-20200312145019-da6875a35672/go.mod",
+		"google.golang.org/genproto v0.0.0-20200331122359-1ee6d9798940/go.mod",
+		"google.golang.org/genproto v0.0.0-20200430143042-b979b6f78d84/go.mod",
+		"google.golang.org/genproto v0.0.0-20200511104702-f5ebc3bea380/go.mod",
+		"google.golang.org/genproto v0.0.0-20200515170657-fc4c6c6a6587/go.mod",
+		"google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013/go.mod",
+		"google.golang.org/genproto v0.0.0-20200618031413-b414f8b61790/go.mod",
+		"google.golang.org/genproto v0.0.0-20200729003335-053ba62fc06f/go.mod",
+		"google.golang.org/genproto v0.0.0-20200804131852-c06518451d9c/go.mod",
+		"google.golang.org/genproto v0.0.0-20200825200019-8632dd797987/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.20.1/go.mod",
+		"google.golang.org/grpc v1.21.1/go.mod",
+		"google.golang.org/grpc v1.23.0/go.mod",
+		"google.golang.org/grpc v1.25.1/go.mod",
+		"google.golang.org/grpc v1.26.0/go.mod",
+		"google.golang.org/grpc v1.27.0/go.mod",
+		"google.golang.org/grpc v1.27.1/go.mod",
+		"google.golang.org/grpc v1.28.0/go.mod",
+		"google.golang.org/grpc v1.29.1/go.mod",
+		"google.golang.org/grpc v1.30.0/go.mod",
+		"google.golang.org/grpc v1.31.0/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.22.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.23.1-0.20200526195155-81db48ad09cc/go.mod",
+		"google.golang.org/protobuf v1.24.0/go.mod",
+		"google.golang.org/protobuf v1.25.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/errgo.v2 v2.1.0/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+		"honnef.co/go/tools v0.0.0-20190106161140-3f1c8253044a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190418001031-e561f6794a2a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190523083050-ea95bdfd59fc/go.mod",
+		"honnef.co/go/tools v0.0.1-2019.2.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.4/go.mod",
+		"rsc.io/binaryregexp v0.2.0/go.mod",
+		"rsc.io/quote/v3 v3.1.0/go.mod",
+		"rsc.io/sampler v1.3.0/go.mod",
+	},
+	"golang.org/x/oauth2@v0.0.0-20210514164344-f6687ab2804c": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"cloud.google.com/go v0.34.0/go.mod",
+		"cloud.google.com/go v0.38.0/go.mod",
+		"cloud.google.com/go v0.44.1/go.mod",
+		"cloud.google.com/go v0.44.2/go.mod",
+		"cloud.google.com/go v0.45.1/go.mod",
+		"cloud.google.com/go v0.46.3/go.mod",
+		"cloud.google.com/go v0.50.0/go.mod",
+		"cloud.google.com/go v0.52.0/go.mod",
+		"cloud.google.com/go v0.53.0/go.mod",
+		"cloud.google.com/go v0.54.0/go.mod",
+		"cloud.google.com/go v0.56.0/go.mod",
+		"cloud.google.com/go v0.57.0/go.mod",
+		"cloud.google.com/go v0.62.0/go.mod",
+		"cloud.google.com/go v0.65.0/go.mod",
+		"cloud.google.com/go/bigquery v1.0.1/go.mod",
+		"cloud.google.com/go/bigquery v1.3.0/go.mod",
+		"cloud.google.com/go/bigquery v1.4.0/go.mod",
+		"cloud.google.com/go/bigquery v1.5.0/go.mod",
+		"cloud.google.com/go/bigquery v1.7.0/go.mod",
+		"cloud.google.com/go/bigquery v1.8.0/go.mod",
+		"cloud.google.com/go/datastore v1.0.0/go.mod",
+		"cloud.google.com/go/datastore v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.0.1/go.mod",
+		"cloud.google.com/go/pubsub v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.2.0/go.mod",
+		"cloud.google.com/go/pubsub v1.3.1/go.mod",
+		"cloud.google.com/go/storage v1.0.0/go.mod",
+		"cloud.google.com/go/storage v1.5.0/go.mod",
+		"cloud.google.com/go/storage v1.6.0/go.mod",
+		"cloud.google.com/go/storage v1.8.0/go.mod",
+		"cloud.google.com/go/storage v1.10.0/go.mod",
+		"dmitri.shuralyov.com/gpu/mtl v0.0.0-20190408044501-666a987793e9/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/BurntSushi/xgb v0.0.0-20160522181843-27f122750802/go.mod",
+		"github.com/census-instrumentation/opencensus-proto v0.2.1/go.mod",
+		"github.com/chzyer/logex v1.1.10/go.mod",
+		"github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e/go.mod",
+		"github.com/chzyer/test v0.0.0-20180213035817-a1ea475d72b1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/cncf/udpa/go v0.0.0-20191209042840-269d4d468f6f/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.1-0.20191026205805-5f8ba28d4473/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.4/go.mod",
+		"github.com/envoyproxy/protoc-gen-validate v0.1.0/go.mod",
+		"github.com/go-gl/glfw v0.0.0-20190409004039-e6da0acd62b1/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20191125211704-12ad95a8df72/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20200222043503-6f7a984d4dc4/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6/go.mod",
+		"github.com/golang/groupcache v0.0.0-20191227052852-215e87163ea7/go.mod",
+		"github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/mock v1.2.0/go.mod",
+		"github.com/golang/mock v1.3.1/go.mod",
+		"github.com/golang/mock v1.4.0/go.mod",
+		"github.com/golang/mock v1.4.1/go.mod",
+		"github.com/golang/mock v1.4.3/go.mod",
+		"github.com/golang/mock v1.4.4/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.3.3/go.mod",
+		"github.com/golang/protobuf v1.3.4/go.mod",
+		"github.com/golang/protobuf v1.3.5/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.1/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/google/btree v0.0.0-20180813153112-4030bb1f1f0c/go.mod",
+		"github.com/google/btree v1.0.0/go.mod",
+		"github.com/google/go-cmp v0.2.0/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.4.1/go.mod",
+		"github.com/google/go-cmp v0.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.1/go.mod",
+		"github.com/google/martian v2.1.0+incompatible/go.mod",
+		"github.com/google/martian/v3 v3.0.0/go.mod",
+		"github.com/google/pprof v0.0.0-20181206194817-3ea8567a2e57/go.mod",
+		"github.com/google/pprof v0.0.0-20190515194954-54271f7e092f/go.mod",
+		"github.com/google/pprof v0.0.0-20191218002539-d4f498aebedc/go.mod",
+		"github.com/google/pprof v0.0.0-20200212024743-f11f1df84d12/go.mod",
+		"github.com/google/pprof v0.0.0-20200229191704-1ebb73c60ed3/go.mod",
+		"github.com/google/pprof v0.0.0-20200430221834-fc25d7d30c6d/go.mod",
+		"github.com/google/pprof v0.0.0-20200708004538-1a94d8640e99/go.mod",
+		"github.com/google/renameio v0.1.0/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.4/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.5/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.0/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.1/go.mod",
+		"github.com/ianlancetaylor/demangle v0.0.0-20181102032728-5e5cf60278f6/go.mod",
+		"github.com/jstemmer/go-junit-report v0.0.0-20190106144839-af01ea7f8024/go.mod",
+		"github.com/jstemmer/go-junit-report v0.9.1/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4/go.mod",
+		"github.com/rogpeppe/go-internal v1.3.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/yuin/goldmark v1.1.25/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.1.32/go.mod",
+		"go.opencensus.io v0.21.0/go.mod",
+		"go.opencensus.io v0.22.0/go.mod",
+		"go.opencensus.io v0.22.2/go.mod",
+		"go.opencensus.io v0.22.3/go.mod",
+		"go.opencensus.io v0.22.4/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20190510104115-cbcb75029529/go.mod",
+		"golang.org/x/crypto v0.0.0-20190605123033-f99c8df09eb5/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/exp v0.0.0-20190306152737-a1d7652674e8/go.mod",
+		"golang.org/x/exp v0.0.0-20190510132918-efd6b22b2522/go.mod",
+		"golang.org/x/exp v0.0.0-20190829153037-c13cbed26979/go.mod",
+		"golang.org/x/exp v0.0.0-20191030013958-a1ab85dbe136/go.mod",
+		"golang.org/x/exp v0.0.0-20191129062945-2f5052295587/go.mod",
+		"golang.org/x/exp v0.0.0-20191227195350-da58074b4299/go.mod",
+		"golang.org/x/exp v0.0.0-20200119233911-0405dc783f0a/go.mod",
+		"golang.org/x/exp v0.0.0-20200207192155-f17229e696bd/go.mod",
+		"golang.org/x/exp v0.0.0-20200224162631-6cc2880d07d6/go.mod",
+		"golang.org/x/image v0.0.0-20190227222117-0694c2d4d067/go.mod",
+		"golang.org/x/image v0.0.0-20190802002840-cff245a6509b/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190301231843-5614ed5bae6f/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/lint v0.0.0-20190409202823-959b441ac422/go.mod",
+		"golang.org/x/lint v0.0.0-20190909230951-414d861bb4ac/go.mod",
+		"golang.org/x/lint v0.0.0-20190930215403-16217165b5de/go.mod",
+		"golang.org/x/lint v0.0.0-20191125180803-fdd1cda4f05f/go.mod",
+		"golang.org/x/lint v0.0.0-20200130185559-910be7a94367/go.mod",
+		"golang.org/x/lint v0.0.0-20200302205851-738671d3881b/go.mod",
+		"golang.org/x/mobile v0.0.0-20190312151609-d3739f865fa6/go.mod",
+		"golang.org/x/mobile v0.0.0-20190719004257-d2bd2a29d028/go.mod",
+		"golang.org/x/mod v0.0.0-20190513183733-4bf6d317e70e/go.mod",
+		"golang.org/x/mod v0.1.0/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191105210325-c90efee705ee/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191107180719-034126e5016b/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190501004415-9ce7a6920f09/go.mod",
+		"golang.org/x/net v0.0.0-20190503192946-f4e77d36d62c/go.mod",
+		"golang.org/x/net v0.0.0-20190603091049-60506f45cf65/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20190628185345-da137c7871d7/go.mod",
+		"golang.org/x/net v0.0.0-20190724013045-ca1201d0de80/go.mod",
+		"golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/net v0.0.0-20200202094626-16171245cfb2/go.mod",
+		"golang.org/x/net v0.0.0-20200222125558-5a598a2470a0/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20200301022130-244492dfa37a/go.mod",
+		"golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e/go.mod",
+		"golang.org/x/net v0.0.0-20200501053045-e0ff5e5a1de5/go.mod",
+		"golang.org/x/net v0.0.0-20200506145744-7e3656a0809f/go.mod",
+		"golang.org/x/net v0.0.0-20200513185701-a91f0712d120/go.mod",
+		"golang.org/x/net v0.0.0-20200520182314-0ba52f642ac2/go.mod",
+		"golang.org/x/net v0.0.0-20200625001655-4c5254603344/go.mod",
+		"golang.org/x/net v0.0.0-20200707034311-ab3426394381/go.mod",
+		"golang.org/x/net v0.0.0-20200822124328-c89045814202/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190226205417-e64efc72b421/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20191202225959-858c2ad4c8b6/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+		"golang.org/x/sync v0.0.0-20190227155943-e225da77a7e6/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20200317015054-43a5402ce75a/go.mod",
+		"golang.org/x/sync v0.0.0-20200625203802-6e8e738ad208/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190312061237-fead79001313/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190502145724-3ef323f4f1fd/go.mod",
+		"golang.org/x/sys v0.0.0-20190507160741-ecd444e8653b/go.mod",
+		"golang.org/x/sys v0.0.0-20190606165138-5da285871e9c/go.mod",
+		"golang.org/x/sys v0.0.0-20190624142023-c5567b49c5d0/go.mod",
+		"golang.org/x/sys v0.0.0-20190726091711-fc99dfbffb4e/go.mod",
+		"golang.org/x/sys v0.0.0-20191001151750-bb3f8db39f24/go.mod",
+		"golang.org/x/sys v0.0.0-20191204072324-ce4227a45e2e/go.mod",
+		"golang.org/x/sys v0.0.0-20191228213918-04cbcbbfeed8/go.mod",
+		"golang.org/x/sys v0.0.0-20200113162924-86b910548bc1/go.mod",
+		"golang.org/x/sys v0.0.0-20200122134326-e047566fdf82/go.mod",
+		"golang.org/x/sys v0.0.0-20200202164722-d101bd2416d5/go.mod",
+		"golang.org/x/sys v0.0.0-20200212091648-12a6c2dcc1e4/go.mod",
+		"golang.org/x/sys v0.0.0-20200223170610-d5e6a3e2c0ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200302150141-5c8b2ff67527/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200331124033-c3d80250170d/go.mod",
+		"golang.org/x/sys v0.0.0-20200501052902-10377860bb8e/go.mod",
+		"golang.org/x/sys v0.0.0-20200511232937-7e40ca221e25/go.mod",
+		"golang.org/x/sys v0.0.0-20200515095857-1151b9dac4a9/go.mod",
+		"golang.org/x/sys v0.0.0-20200523222454-059865788121/go.mod",
+		"golang.org/x/sys v0.0.0-20200803210538-64077c9b5642/go.mod",
+		"golang.org/x/text v0.0.0-20170915032832-14c0d48ead0c/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.1-0.20180807135948-17ff2d5776d2/go.mod",
+		"golang.org/x/text v0.3.2/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/time v0.0.0-20181108054448-85acf8d2951c/go.mod",
+		"golang.org/x/time v0.0.0-20190308202827-9d24e82272b4/go.mod",
+		"golang.org/x/time v0.0.0-20191024005414-555d28b269f0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20190312151545-0bb0c0a6e846/go.mod",
+		"golang.org/x/tools v0.0.0-20190312170243-e65039ee4138/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+		"golang.org/x/tools v0.0.0-20190506145303-2d16b83fe98c/go.mod",
+		"golang.org/x/tools v0.0.0-20190524140312-2c0ae7006135/go.mod",
+		"golang.org/x/tools v0.0.0-20190606124116-d0a3d012864b/go.mod",
+		"golang.org/x/tools v0.0.0-20190621195816-6e04913cbbac/go.mod",
+		"golang.org/x/tools v0.0.0-20190628153133-6cdbf07be9d0/go.mod",
+		"golang.org/x/tools v0.0.0-20190816200558-6889da9d5479/go.mod",
+		"golang.org/x/tools v0.0.0-20190911174233-4f2ddba30aff/go.mod",
+		"golang.org/x/tools v0.0.0-20191012152004-8de300cfc20a/go.mod",
+		"golang.org/x/tools v0.0.0-20191113191852-77e3bb0ad9e7/go.mod",
+		"golang.org/x/tools v0.0.0-20191115202509-3a792d9c32b2/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20191125144606-a911d9008d1f/go.mod",
+		"golang.org/x/tools v0.0.0-20191130070609-6e064ea0cf2d/go.mod",
+		"golang.org/x/tools v0.0.0-20191216173652-a0e659d51361/go.mod",
+		"golang.org/x/tools v0.0.0-20191227053925-7b8e75db28f4/go.mod",
+		"golang.org/x/tools v0.0.0-20200117161641-43d50277825c/go.mod",
+		"golang.org/x/tools v0.0.0-20200122220014-bf1340f18c4a/go.mod",
+		"golang.org/x/tools v0.0.0-20200130002326-2f3ba24bd6e7/go.mod",
+		"golang.org/x/tools v0.0.0-20200204074204-1cc6d1ef6c74/go.mod",
+		"golang.org/x/tools v0.0.0-20200207183749-b753a1ba74fa/go.mod",
+		"golang.org/x/tools v0.0.0-20200212150539-ea181f53ac56/go.mod",
+		"golang.org/x/tools v0.0.0-20200224181240-023911ca70b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200227222343-706bc42d1f0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200304193943-95d2e580d8eb/go.mod",
+		"golang.org/x/tools v0.0.0-20200312045724-11d5b4c81c7d/go.mod",
+		"golang.org/x/tools v0.0.0-20200331025713-a30bf2db82d4/go.mod",
+		"golang.org/x/tools v0.0.0-20200501065659-ab2804fb9c9d/go.mod",
+		"golang.org/x/tools v0.0.0-20200512131952-2bc93b1c0c88/go.mod",
+		"golang.org/x/tools v0.0.0-20200515010526-7d3b6ebf133d/go.mod",
+		"golang.org/x/tools v0.0.0-20200618134242-20370b0cb4b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200729194436-6467de6f59a7/go.mod",
+		"golang.org/x/tools v0.0.0-20200804011535-6c149bb5ef0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200825202427-b303f430e36d/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/api v0.4.0/go.mod",
+		"google.golang.org/api v0.7.0/go.mod",
+		"google.golang.org/api v0.8.0/go.mod",
+		"google.golang.org/api v0.9.0/go.mod",
+		"google.golang.org/api v0.13.0/go.mod",
+		"google.golang.org/api v0.14.0/go.mod",
+		"google.golang.org/api v0.15.0/go.mod",
+		"google.golang.org/api v0.17.0/go.mod",
+		"google.golang.org/api v0.18.0/go.mod",
+		"google.golang.org/api v0.19.0/go.mod",
+		"google.golang.org/api v0.20.0/go.mod",
+		"google.golang.org/api v0.22.0/go.mod",
+		"google.golang.org/api v0.24.0/go.mod",
+		"google.golang.org/api v0.28.0/go.mod",
+		"google.golang.org/api v0.29.0/go.mod",
+		"google.golang.org/api v0.30.0/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/appengine v1.5.0/go.mod",
+		"google.golang.org/appengine v1.6.1/go.mod",
+		"google.golang.org/appengine v1.6.5/go.mod",
+		"google.golang.org/appengine v1.6.6/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190307195333-5fe7a883aa19/go.mod",
+		"google.golang.org/genproto v0.0.0-20190418145605-e7d98fc518a7/go.mod",
+		"google.golang.org/genproto v0.0.0-20190425155659-357c62f0e4bb/go.mod",
+		"google.golang.org/genproto v0.0.0-20190502173448-54afdca5d873/go.mod",
+		"google.golang.org/genproto v0.0.0-20190801165951-fa694d86fc64/go.mod",
+		"google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55/go.mod",
+		"google.golang.org/genproto v0.0.0-20190911173649-1774047e7e51/go.mod",
+		"google.golang.org/genproto v0.0.0-20191108220845-16a3f7862a1a/go.mod",
+		"google.golang.org/genproto v0.0.0-20191115194625-c23dd37a84c9/go.mod",
+		"google.golang.org/genproto v0.0.0-20191216164720-4f79533eabd1/go.mod",
+		"google.golang.org/genproto v0.0.0-20191230161307-f3c370f40bfb/go.mod",
+		"google.golang.org/genproto v0.0.0-20200115191322-ca5a22157cba/go.mod",
+		"google.golang.org/genproto v0.0.0-20200122232147-0452cf42e150/go.mod",
+		"google.golang.org/genproto v0.0.0-20200204135345-fa8e72b47b90/go.mod",
+		"google.golang.org/genproto v0.0.0-20200212174721-66ed5ce911ce/go.mod",
+		"google.golang.org/genproto v0.0.0-20200224152610-e50cd9704f63/go.mod",
+		"google.golang.org/genproto v0.0.0-20200228133532-8c2c7df3a383/go.mod",
+		"google.golang.org/genproto v0.0.0-20200305110556-506484158171/go.mod",
+		"google.golang.org/genproto v0.0.0-20200312145019-da6875a35672/go.mod",
+		"google.golang.org/genproto v0.0.0-20200331122359-1ee6d9798940/go.mod",
+		"google.golang.org/genproto v0.0.0-20200430143042-b979b6f78d84/go.mod",
+		"google.golang.org/genproto v0.0.0-20200511104702-f5ebc3bea380/go.mod",
+		"google.golang.org/genproto v0.0.0-20200515170657-fc4c6c6a6587/go.mod",
+		"google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013/go.mod",
+		"google.golang.org/genproto v0.0.0-20200618031413-b414f8b61790/go.mod",
+		"google.golang.org/genproto v0.0.0-20200729003335-053ba62fc06f/go.mod",
+		"google.golang.org/genproto v0.0.0-20200804131852-c06518451d9c/go.mod",
+		"google.golang.org/genproto v0.0.0-20200825200019-8632dd797987/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.20.1/go.mod",
+		"google.golang.org/grpc v1.21.1/go.mod",
+		"google.golang.org/grpc v1.23.0/go.mod",
+		"google.golang.org/grpc v1.25.1/go.mod",
+		"google.golang.org/grpc v1.26.0/go.mod",
+		"google.golang.org/grpc v1.27.0/go.mod",
+		"google.golang.org/grpc v1.27.1/go.mod",
+		"google.golang.org/grpc v1.28.0/go.mod",
+		"google.golang.org/grpc v1.29.1/go.mod",
+		"google.golang.org/grpc v1.30.0/go.mod",
+		"google.golang.org/grpc v1.31.0/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.22.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.23.1-0.20200526195155-81db48ad09cc/go.mod",
+		"google.golang.org/protobuf v1.24.0/go.mod",
+		"google.golang.org/protobuf v1.25.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/errgo.v2 v2.1.0/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+		"honnef.co/go/tools v0.0.0-20190106161140-3f1c8253044a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190418001031-e561f6794a2a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190523083050-ea95bdfd59fc/go.mod",
+		"honnef.co/go/tools v0.0.1-2019.2.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.4/go.mod",
+		"rsc.io/binaryregexp v0.2.0/go.mod",
+		"rsc.io/quote/v3 v3.1.0/go.mod",
+		"rsc.io/sampler v1.3.0/go.mod",
+	},
+	"golang.org/x/oauth2@v0.0.0-20220223155221-ee480838109b": {
+		"cloud.google.com/go v0.26.0/go.mod",
+		"cloud.google.com/go v0.34.0/go.mod",
+		"cloud.google.com/go v0.38.0/go.mod",
+		"cloud.google.com/go v0.44.1/go.mod",
+		"cloud.google.com/go v0.44.2/go.mod",
+		"cloud.google.com/go v0.45.1/go.mod",
+		"cloud.google.com/go v0.46.3/go.mod",
+		"cloud.google.com/go v0.50.0/go.mod",
+		"cloud.google.com/go v0.52.0/go.mod",
+		"cloud.google.com/go v0.53.0/go.mod",
+		"cloud.google.com/go v0.54.0/go.mod",
+		"cloud.google.com/go v0.56.0/go.mod",
+		"cloud.google.com/go v0.57.0/go.mod",
+		"cloud.google.com/go v0.62.0/go.mod",
+		"cloud.google.com/go v0.65.0/go.mod",
+		"cloud.google.com/go/bigquery v1.0.1/go.mod",
+		"cloud.google.com/go/bigquery v1.3.0/go.mod",
+		"cloud.google.com/go/bigquery v1.4.0/go.mod",
+		"cloud.google.com/go/bigquery v1.5.0/go.mod",
+		"cloud.google.com/go/bigquery v1.7.0/go.mod",
+		"cloud.google.com/go/bigquery v1.8.0/go.mod",
+		"cloud.google.com/go/datastore v1.0.0/go.mod",
+		"cloud.google.com/go/datastore v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.0.1/go.mod",
+		"cloud.google.com/go/pubsub v1.1.0/go.mod",
+		"cloud.google.com/go/pubsub v1.2.0/go.mod",
+		"cloud.google.com/go/pubsub v1.3.1/go.mod",
+		"cloud.google.com/go/storage v1.0.0/go.mod",
+		"cloud.google.com/go/storage v1.5.0/go.mod",
+		"cloud.google.com/go/storage v1.6.0/go.mod",
+		"cloud.google.com/go/storage v1.8.0/go.mod",
+		"cloud.google.com/go/storage v1.10.0/go.mod",
+		"dmitri.shuralyov.com/gpu/mtl v0.0.0-20190408044501-666a987793e9/go.mod",
+		"github.com/BurntSushi/toml v0.3.1/go.mod",
+		"github.com/BurntSushi/xgb v0.0.0-20160522181843-27f122750802/go.mod",
+		"github.com/census-instrumentation/opencensus-proto v0.2.1/go.mod",
+		"github.com/chzyer/logex v1.1.10/go.mod",
+		"github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e/go.mod",
+		"github.com/chzyer/test v0.0.0-20180213035817-a1ea475d72b1/go.mod",
+		"github.com/client9/misspell v0.3.4/go.mod",
+		"github.com/cncf/udpa/go v0.0.0-20191209042840-269d4d468f6f/go.mod",
+		"github.com/davecgh/go-spew v1.1.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.0/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.1-0.20191026205805-5f8ba28d4473/go.mod",
+		"github.com/envoyproxy/go-control-plane v0.9.4/go.mod",
+		"github.com/envoyproxy/protoc-gen-validate v0.1.0/go.mod",
+		"github.com/go-gl/glfw v0.0.0-20190409004039-e6da0acd62b1/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20191125211704-12ad95a8df72/go.mod",
+		"github.com/go-gl/glfw/v3.3/glfw v0.0.0-20200222043503-6f7a984d4dc4/go.mod",
+		"github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b/go.mod",
+		"github.com/golang/groupcache v0.0.0-20190702054246-869f871628b6/go.mod",
+		"github.com/golang/groupcache v0.0.0-20191227052852-215e87163ea7/go.mod",
+		"github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e/go.mod",
+		"github.com/golang/mock v1.1.1/go.mod",
+		"github.com/golang/mock v1.2.0/go.mod",
+		"github.com/golang/mock v1.3.1/go.mod",
+		"github.com/golang/mock v1.4.0/go.mod",
+		"github.com/golang/mock v1.4.1/go.mod",
+		"github.com/golang/mock v1.4.3/go.mod",
+		"github.com/golang/mock v1.4.4/go.mod",
+		"github.com/golang/protobuf v1.2.0/go.mod",
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"github.com/golang/protobuf v1.3.2/go.mod",
+		"github.com/golang/protobuf v1.3.3/go.mod",
+		"github.com/golang/protobuf v1.3.4/go.mod",
+		"github.com/golang/protobuf v1.3.5/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.1.0.20200221234624-67d41d38c208/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.2/go.mod",
+		"github.com/golang/protobuf v1.4.0-rc.4.0.20200313231945-b860323f09d0/go.mod",
+		"github.com/golang/protobuf v1.4.0/go.mod",
+		"github.com/golang/protobuf v1.4.1/go.mod",
+		"github.com/golang/protobuf v1.4.2/go.mod",
+		"github.com/google/btree v0.0.0-20180813153112-4030bb1f1f0c/go.mod",
+		"github.com/google/btree v1.0.0/go.mod",
+		"github.com/google/go-cmp v0.2.0/go.mod",
+		"github.com/google/go-cmp v0.3.0/go.mod",
+		"github.com/google/go-cmp v0.3.1/go.mod",
+		"github.com/google/go-cmp v0.4.0/go.mod",
+		"github.com/google/go-cmp v0.4.1/go.mod",
+		"github.com/google/go-cmp v0.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.1/go.mod",
+		"github.com/google/martian v2.1.0+incompatible/go.mod",
+		"github.com/google/martian/v3 v3.0.0/go.mod",
+		"github.com/google/pprof v0.0.0-20181206194817-3ea8567a2e57/go.mod",
+		"github.com/google/pprof v0.0.0-20190515194954-54271f7e092f/go.mod",
+		"github.com/google/pprof v0.0.0-20191218002539-d4f498aebedc/go.mod",
+		"github.com/google/pprof v0.0.0-20200212024743-f11f1df84d12/go.mod",
+		"github.com/google/pprof v0.0.0-20200229191704-1ebb73c60ed3/go.mod",
+		"github.com/google/pprof v0.0.0-20200430221834-fc25d7d30c6d/go.mod",
+		"github.com/google/pprof v0.0.0-20200708004538-1a94d8640e99/go.mod",
+		"github.com/google/renameio v0.1.0/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.4/go.mod",
+		"github.com/googleapis/gax-go/v2 v2.0.5/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.0/go.mod",
+		"github.com/hashicorp/golang-lru v0.5.1/go.mod",
+		"github.com/ianlancetaylor/demangle v0.0.0-20181102032728-5e5cf60278f6/go.mod",
+		"github.com/jstemmer/go-junit-report v0.0.0-20190106144839-af01ea7f8024/go.mod",
+		"github.com/jstemmer/go-junit-report v0.9.1/go.mod",
+		"github.com/kisielk/gotool v1.0.0/go.mod",
+		"github.com/kr/pretty v0.1.0/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+		"github.com/pmezard/go-difflib v1.0.0/go.mod",
+		"github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4/go.mod",
+		"github.com/rogpeppe/go-internal v1.3.0/go.mod",
+		"github.com/stretchr/objx v0.1.0/go.mod",
+		"github.com/stretchr/testify v1.4.0/go.mod",
+		"github.com/yuin/goldmark v1.1.25/go.mod",
+		"github.com/yuin/goldmark v1.1.27/go.mod",
+		"github.com/yuin/goldmark v1.1.32/go.mod",
+		"go.opencensus.io v0.21.0/go.mod",
+		"go.opencensus.io v0.22.0/go.mod",
+		"go.opencensus.io v0.22.2/go.mod",
+		"go.opencensus.io v0.22.3/go.mod",
+		"go.opencensus.io v0.22.4/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20190510104115-cbcb75029529/go.mod",
+		"golang.org/x/crypto v0.0.0-20190605123033-f99c8df09eb5/go.mod",
+		"golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550/go.mod",
+		"golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9/go.mod",
+		"golang.org/x/exp v0.0.0-20190121172915-509febef88a4/go.mod",
+		"golang.org/x/exp v0.0.0-20190306152737-a1d7652674e8/go.mod",
+		"golang.org/x/exp v0.0.0-20190510132918-efd6b22b2522/go.mod",
+		"golang.org/x/exp v0.0.0-20190829153037-c13cbed26979/go.mod",
+		"golang.org/x/exp v0.0.0-20191030013958-a1ab85dbe136/go.mod",
+		"golang.org/x/exp v0.0.0-20191129062945-2f5052295587/go.mod",
+		"golang.org/x/exp v0.0.0-20191227195350-da58074b4299/go.mod",
+		"golang.org/x/exp v0.0.0-20200119233911-0405dc783f0a/go.mod",
+		"golang.org/x/exp v0.0.0-20200207192155-f17229e696bd/go.mod",
+		"golang.org/x/exp v0.0.0-20200224162631-6cc2880d07d6/go.mod",
+		"golang.org/x/image v0.0.0-20190227222117-0694c2d4d067/go.mod",
+		"golang.org/x/image v0.0.0-20190802002840-cff245a6509b/go.mod",
+		"golang.org/x/lint v0.0.0-20181026193005-c67002cb31c3/go.mod",
+		"golang.org/x/lint v0.0.0-20190227174305-5b3e6a55c961/go.mod",
+		"golang.org/x/lint v0.0.0-20190301231843-5614ed5bae6f/go.mod",
+		"golang.org/x/lint v0.0.0-20190313153728-d0100b6bd8b3/go.mod",
+		"golang.org/x/lint v0.0.0-20190409202823-959b441ac422/go.mod",
+		"golang.org/x/lint v0.0.0-20190909230951-414d861bb4ac/go.mod",
+		"golang.org/x/lint v0.0.0-20190930215403-16217165b5de/go.mod",
+		"golang.org/x/lint v0.0.0-20191125180803-fdd1cda4f05f/go.mod",
+		"golang.org/x/lint v0.0.0-20200130185559-910be7a94367/go.mod",
+		"golang.org/x/lint v0.0.0-20200302205851-738671d3881b/go.mod",
+		"golang.org/x/mobile v0.0.0-20190312151609-d3739f865fa6/go.mod",
+		"golang.org/x/mobile v0.0.0-20190719004257-d2bd2a29d028/go.mod",
+		"golang.org/x/mod v0.0.0-20190513183733-4bf6d317e70e/go.mod",
+		"golang.org/x/mod v0.1.0/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191105210325-c90efee705ee/go.mod",
+		"golang.org/x/mod v0.1.1-0.20191107180719-034126e5016b/go.mod",
+		"golang.org/x/mod v0.2.0/go.mod",
+		"golang.org/x/mod v0.3.0/go.mod",
+		"golang.org/x/net v0.0.0-20180724234803-3673e40ba225/go.mod",
+		"golang.org/x/net v0.0.0-20180826012351-8a410e7b638d/go.mod",
+		"golang.org/x/net v0.0.0-20190108225652-1e06a53dbb7e/go.mod",
+		"golang.org/x/net v0.0.0-20190213061140-3a22650c66bd/go.mod",
+		"golang.org/x/net v0.0.0-20190311183353-d8887717615a/go.mod",
+		"golang.org/x/net v0.0.0-20190404232315-eb5bcb51f2a3/go.mod",
+		"golang.org/x/net v0.0.0-20190501004415-9ce7a6920f09/go.mod",
+		"golang.org/x/net v0.0.0-20190503192946-f4e77d36d62c/go.mod",
+		"golang.org/x/net v0.0.0-20190603091049-60506f45cf65/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20190628185345-da137c7871d7/go.mod",
+		"golang.org/x/net v0.0.0-20190724013045-ca1201d0de80/go.mod",
+		"golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553/go.mod",
+		"golang.org/x/net v0.0.0-20200114155413-6afb5195e5aa/go.mod",
+		"golang.org/x/net v0.0.0-20200202094626-16171245cfb2/go.mod",
+		"golang.org/x/net v0.0.0-20200222125558-5a598a2470a0/go.mod",
+		"golang.org/x/net v0.0.0-20200226121028-0de0cce0169b/go.mod",
+		"golang.org/x/net v0.0.0-20200301022130-244492dfa37a/go.mod",
+		"golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e/go.mod",
+		"golang.org/x/net v0.0.0-20200501053045-e0ff5e5a1de5/go.mod",
+		"golang.org/x/net v0.0.0-20200506145744-7e3656a0809f/go.mod",
+		"golang.org/x/net v0.0.0-20200513185701-a91f0712d120/go.mod",
+		"golang.org/x/net v0.0.0-20200520182314-0ba52f642ac2/go.mod",
+		"golang.org/x/net v0.0.0-20200625001655-4c5254603344/go.mod",
+		"golang.org/x/net v0.0.0-20200707034311-ab3426394381/go.mod",
+		"golang.org/x/net v0.0.0-20200822124328-c89045814202/go.mod",
+		"golang.org/x/net v0.0.0-20220127200216-cd36cc0744dd/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20180821212333-d2e6202438be/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190226205417-e64efc72b421/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20191202225959-858c2ad4c8b6/go.mod",
+		"golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d/go.mod",
+		"golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f/go.mod",
+		"golang.org/x/sync v0.0.0-20181108010431-42b317875d0f/go.mod",
+		"golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4/go.mod",
+		"golang.org/x/sync v0.0.0-20190227155943-e225da77a7e6/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e/go.mod",
+		"golang.org/x/sync v0.0.0-20200317015054-43a5402ce75a/go.mod",
+		"golang.org/x/sync v0.0.0-20200625203802-6e8e738ad208/go.mod",
+		"golang.org/x/sys v0.0.0-20180830151530-49385e6e1522/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20190312061237-fead79001313/go.mod",
+		"golang.org/x/sys v0.0.0-20190412213103-97732733099d/go.mod",
+		"golang.org/x/sys v0.0.0-20190502145724-3ef323f4f1fd/go.mod",
+		"golang.org/x/sys v0.0.0-20190507160741-ecd444e8653b/go.mod",
+		"golang.org/x/sys v0.0.0-20190606165138-5da285871e9c/go.mod",
+		"golang.org/x/sys v0.0.0-20190624142023-c5567b49c5d0/go.mod",
+		"golang.org/x/sys v0.0.0-20190726091711-fc99dfbffb4e/go.mod",
+		"golang.org/x/sys v0.0.0-20191001151750-bb3f8db39f24/go.mod",
+		"golang.org/x/sys v0.0.0-20191204072324-ce4227a45e2e/go.mod",
+		"golang.org/x/sys v0.0.0-20191228213918-04cbcbbfeed8/go.mod",
+		"golang.org/x/sys v0.0.0-20200113162924-86b910548bc1/go.mod",
+		"golang.org/x/sys v0.0.0-20200122134326-e047566fdf82/go.mod",
+		"golang.org/x/sys v0.0.0-20200202164722-d101bd2416d5/go.mod",
+		"golang.org/x/sys v0.0.0-20200212091648-12a6c2dcc1e4/go.mod",
+		"golang.org/x/sys v0.0.0-20200223170610-d5e6a3e2c0ae/go.mod",
+		"golang.org/x/sys v0.0.0-20200302150141-5c8b2ff67527/go.mod",
+		"golang.org/x/sys v0.0.0-20200323222414-85ca7c5b95cd/go.mod",
+		"golang.org/x/sys v0.0.0-20200331124033-c3d80250170d/go.mod",
+		"golang.org/x/sys v0.0.0-20200501052902-10377860bb8e/go.mod",
+		"golang.org/x/sys v0.0.0-20200511232937-7e40ca221e25/go.mod",
+		"golang.org/x/sys v0.0.0-20200515095857-1151b9dac4a9/go.mod",
+		"golang.org/x/sys v0.0.0-20200523222454-059865788121/go.mod",
+		"golang.org/x/sys v0.0.0-20200803210538-64077c9b5642/go.mod",
+		"golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1/go.mod",
+		"golang.org/x/sys v0.0.0-20211216021012-1d35b9e2eb4e/go.mod",
+		"golang.org/x/term v0.0.0-20210927222741-03fcf44c2211/go.mod",
+		"golang.org/x/text v0.0.0-20170915032832-14c0d48ead0c/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.1-0.20180807135948-17ff2d5776d2/go.mod",
+		"golang.org/x/text v0.3.2/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.7/go.mod",
+		"golang.org/x/time v0.0.0-20181108054448-85acf8d2951c/go.mod",
+		"golang.org/x/time v0.0.0-20190308202827-9d24e82272b4/go.mod",
+		"golang.org/x/time v0.0.0-20191024005414-555d28b269f0/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20190114222345-bf090417da8b/go.mod",
+		"golang.org/x/tools v0.0.0-20190226205152-f727befe758c/go.mod",
+		"golang.org/x/tools v0.0.0-20190311212946-11955173bddd/go.mod",
+		"golang.org/x/tools v0.0.0-20190312151545-0bb0c0a6e846/go.mod",
+		"golang.org/x/tools v0.0.0-20190312170243-e65039ee4138/go.mod",
+		"golang.org/x/tools v0.0.0-20190425150028-36563e24a262/go.mod",
+		"golang.org/x/tools v0.0.0-20190506145303-2d16b83fe98c/go.mod",
+		"golang.org/x/tools v0.0.0-20190524140312-2c0ae7006135/go.mod",
+		"golang.org/x/tools v0.0.0-20190606124116-d0a3d012864b/go.mod",
+		"golang.org/x/tools v0.0.0-20190621195816-6e04913cbbac/go.mod",
+		"golang.org/x/tools v0.0.0-20190628153133-6cdbf07be9d0/go.mod",
+		"golang.org/x/tools v0.0.0-20190816200558-6889da9d5479/go.mod",
+		"golang.org/x/tools v0.0.0-20190911174233-4f2ddba30aff/go.mod",
+		"golang.org/x/tools v0.0.0-20191012152004-8de300cfc20a/go.mod",
+		"golang.org/x/tools v0.0.0-20191113191852-77e3bb0ad9e7/go.mod",
+		"golang.org/x/tools v0.0.0-20191115202509-3a792d9c32b2/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.0.0-20191125144606-a911d9008d1f/go.mod",
+		"golang.org/x/tools v0.0.0-20191130070609-6e064ea0cf2d/go.mod",
+		"golang.org/x/tools v0.0.0-20191216173652-a0e659d51361/go.mod",
+		"golang.org/x/tools v0.0.0-20191227053925-7b8e75db28f4/go.mod",
+		"golang.org/x/tools v0.0.0-20200117161641-43d50277825c/go.mod",
+		"golang.org/x/tools v0.0.0-20200122220014-bf1340f18c4a/go.mod",
+		"golang.org/x/tools v0.0.0-20200130002326-2f3ba24bd6e7/go.mod",
+		"golang.org/x/tools v0.0.0-20200204074204-1cc6d1ef6c74/go.mod",
+		"golang.org/x/tools v0.0.0-20200207183749-b753a1ba74fa/go.mod",
+		"golang.org/x/tools v0.0.0-20200212150539-ea181f53ac56/go.mod",
+		"golang.org/x/tools v0.0.0-20200224181240-023911ca70b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200227222343-706bc42d1f0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200304193943-95d2e580d8eb/go.mod",
+		"golang.org/x/tools v0.0.0-20200312045724-11d5b4c81c7d/go.mod",
+		"golang.org/x/tools v0.0.0-20200331025713-a30bf2db82d4/go.mod",
+		"golang.org/x/tools v0.0.0-20200501065659-ab2804fb9c9d/go.mod",
+		"golang.org/x/tools v0.0.0-20200512131952-2bc93b1c0c88/go.mod",
+		"golang.org/x/tools v0.0.0-20200515010526-7d3b6ebf133d/go.mod",
+		"golang.org/x/tools v0.0.0-20200618134242-20370b0cb4b2/go.mod",
+		"golang.org/x/tools v0.0.0-20200729194436-6467de6f59a7/go.mod",
+		"golang.org/x/tools v0.0.0-20200804011535-6c149bb5ef0d/go.mod",
+		"golang.org/x/tools v0.0.0-20200825202427-b303f430e36d/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1/go.mod",
+		"google.golang.org/api v0.4.0/go.mod",
+		"google.golang.org/api v0.7.0/go.mod",
+		"google.golang.org/api v0.8.0/go.mod",
+		"google.golang.org/api v0.9.0/go.mod",
+		"google.golang.org/api v0.13.0/go.mod",
+		"google.golang.org/api v0.14.0/go.mod",
+		"google.golang.org/api v0.15.0/go.mod",
+		"google.golang.org/api v0.17.0/go.mod",
+		"google.golang.org/api v0.18.0/go.mod",
+		"google.golang.org/api v0.19.0/go.mod",
+		"google.golang.org/api v0.20.0/go.mod",
+		"google.golang.org/api v0.22.0/go.mod",
+		"google.golang.org/api v0.24.0/go.mod",
+		"google.golang.org/api v0.28.0/go.mod",
+		"google.golang.org/api v0.29.0/go.mod",
+		"google.golang.org/api v0.30.0/go.mod",
+		"google.golang.org/appengine v1.1.0/go.mod",
+		"google.golang.org/appengine v1.4.0/go.mod",
+		"google.golang.org/appengine v1.5.0/go.mod",
+		"google.golang.org/appengine v1.6.1/go.mod",
+		"google.golang.org/appengine v1.6.5/go.mod",
+		"google.golang.org/appengine v1.6.6/go.mod",
+		"google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8/go.mod",
+		"google.golang.org/genproto v0.0.0-20190307195333-5fe7a883aa19/go.mod",
+		"google.golang.org/genproto v0.0.0-20190418145605-e7d98fc518a7/go.mod",
+		"google.golang.org/genproto v0.0.0-20190425155659-357c62f0e4bb/go.mod",
+		"google.golang.org/genproto v0.0.0-20190502173448-54afdca5d873/go.mod",
+		"google.golang.org/genproto v0.0.0-20190801165951-fa694d86fc64/go.mod",
+		"google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55/go.mod",
+		"google.golang.org/genproto v0.0.0-20190911173649-1774047e7e51/go.mod",
+		"google.golang.org/genproto v0.0.0-20191108220845-16a3f7862a1a/go.mod",
+		"google.golang.org/genproto v0.0.0-20191115194625-c23dd37a84c9/go.mod",
+		"google.golang.org/genproto v0.0.0-20191216164720-4f79533eabd1/go.mod",
+		"google.golang.org/genproto v0.0.0-20191230161307-f3c370f40bfb/go.mod",
+		"google.golang.org/genproto v0.0.0-20200115191322-ca5a22157cba/go.mod",
+		"google.golang.org/genproto v0.0.0-20200122232147-0452cf42e150/go.mod",
+		"google.golang.org/genproto v0.0.0-20200204135345-fa8e72b47b90/go.mod",
+		"google.golang.org/genproto v0.0.0-20200212174721-66ed5ce911ce/go.mod",
+		"google.golang.org/genproto v0.0.0-20200224152610-e50cd9704f63/go.mod",
+		"google.golang.org/genproto v0.0.0-20200228133532-8c2c7df3a383/go.mod",
+		"google.golang.org/genproto v0.0.0-20200305110556-506484158171/go.mod",
+		"google.golang.org/genproto v0.0.0-20200312145019-da6875a35672/go.mod",
+		"google.golang.org/genproto v0.0.0-20200331122359-1ee6d9798940/go.mod",
+		"google.golang.org/genproto v0.0.0-20200430143042-b979b6f78d84/go.mod",
+		"google.golang.org/genproto v0.0.0-20200511104702-f5ebc3bea380/go.mod",
+		"google.golang.org/genproto v0.0.0-20200515170657-fc4c6c6a6587/go.mod",
+		"google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013/go.mod",
+		"google.golang.org/genproto v0.0.0-20200618031413-b414f8b61790/go.mod",
+		"google.golang.org/genproto v0.0.0-20200729003335-053ba62fc06f/go.mod",
+		"google.golang.org/genproto v0.0.0-20200804131852-c06518451d9c/go.mod",
+		"google.golang.org/genproto v0.0.0-20200825200019-8632dd797987/go.mod",
+		"google.golang.org/grpc v1.19.0/go.mod",
+		"google.golang.org/grpc v1.20.1/go.mod",
+		"google.golang.org/grpc v1.21.1/go.mod",
+		"google.golang.org/grpc v1.23.0/go.mod",
+		"google.golang.org/grpc v1.25.1/go.mod",
+		"google.golang.org/grpc v1.26.0/go.mod",
+		"google.golang.org/grpc v1.27.0/go.mod",
+		"google.golang.org/grpc v1.27.1/go.mod",
+		"google.golang.org/grpc v1.28.0/go.mod",
+		"google.golang.org/grpc v1.29.1/go.mod",
+		"google.golang.org/grpc v1.30.0/go.mod",
+		"google.golang.org/grpc v1.31.0/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200109180630-ec00e32a8dfd/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200221191635-4d8936d0db64/go.mod",
+		"google.golang.org/protobuf v0.0.0-20200228230310-ab0ca4ff8a60/go.mod",
+		"google.golang.org/protobuf v1.20.1-0.20200309200217-e05f789c0967/go.mod",
+		"google.golang.org/protobuf v1.21.0/go.mod",
+		"google.golang.org/protobuf v1.22.0/go.mod",
+		"google.golang.org/protobuf v1.23.0/go.mod",
+		"google.golang.org/protobuf v1.23.1-0.20200526195155-81db48ad09cc/go.mod",
+		"google.golang.org/protobuf v1.24.0/go.mod",
+		"google.golang.org/protobuf v1.25.0/go.mod",
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+		"gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127/go.mod",
+		"gopkg.in/errgo.v2 v2.1.0/go.mod",
+		"gopkg.in/yaml.v2 v2.2.2/go.mod",
+		"honnef.co/go/tools v0.0.0-20190102054323-c2f93a96b099/go.mod",
+		"honnef.co/go/tools v0.0.0-20190106161140-3f1c8253044a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190418001031-e561f6794a2a/go.mod",
+		"honnef.co/go/tools v0.0.0-20190523083050-ea95bdfd59fc/go.mod",
+		"honnef.co/go/tools v0.0.1-2019.2.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.3/go.mod",
+		"honnef.co/go/tools v0.0.1-2020.1.4/go.mod",
+		"rsc.io/binaryregexp v0.2.0/go.mod",
+		"rsc.io/quote/v3 v3.1.0/go.mod",
+		"rsc.io/sampler v1.3.0/go.mod",
+	},
+	"google.golang.org/appengine@v1.6.7": {
+		"github.com/golang/protobuf v1.3.1/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/net v0.0.0-20190603091049-60506f45cf65/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.2/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+	},
+	"google.golang.org/appengine@v1.6.8": {
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/golang/protobuf v1.5.2/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/yuin/goldmark v1.4.13/go.mod",
+		"golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2/go.mod",
+		"golang.org/x/crypto v0.0.0-20210921155107-089bfa567519/go.mod",
+		"golang.org/x/mod v0.6.0-dev.0.20220419223038-86c51ed26bb4/go.mod",
+		"golang.org/x/net v0.0.0-20190620200207-3b0461eec859/go.mod",
+		"golang.org/x/net v0.0.0-20210226172049-e18ecbb05110/go.mod",
+		"golang.org/x/net v0.0.0-20220722155237-a158d28d115b/go.mod",
+		"golang.org/x/sync v0.0.0-20190423024810-112230192c58/go.mod",
+		"golang.org/x/sync v0.0.0-20220722155255-886fb9371eb4/go.mod",
+		"golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a/go.mod",
+		"golang.org/x/sys v0.0.0-20201119102817-f84b799fce68/go.mod",
+		"golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1/go.mod",
+		"golang.org/x/sys v0.0.0-20220520151302-bc2c85ada10a/go.mod",
+		"golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f/go.mod",
+		"golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1/go.mod",
+		"golang.org/x/term v0.0.0-20210927222741-03fcf44c2211/go.mod",
+		"golang.org/x/text v0.3.0/go.mod",
+		"golang.org/x/text v0.3.3/go.mod",
+		"golang.org/x/text v0.3.7/go.mod",
+		"golang.org/x/text v0.3.8/go.mod",
+		"golang.org/x/tools v0.0.0-20180917221912-90fa682c2a6e/go.mod",
+		"golang.org/x/tools v0.0.0-20191119224855-298f0cb1881e/go.mod",
+		"golang.org/x/tools v0.1.12/go.mod",
+		"golang.org/x/xerrors v0.0.0-20190717185122-a985d3407aa7/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.26.0/go.mod",
+	},
+	"google.golang.org/grpc@v1.60.1": {
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/golang/protobuf v1.5.3/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"github.com/google/go-cmp v0.5.9",
+		"github.com/google/go-cmp v0.5.9/go.mod",
+		"golang.org/x/net v0.16.0/go.mod",
+		"golang.org/x/sys v0.13.0/go.mod",
+		"golang.org/x/text v0.13.0/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/genproto/googleapis/rpc v0.0.0-20231002182017-d307bd883b97/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+		"google.golang.org/protobuf v1.26.0/go.mod",
+		"google.golang.org/protobuf v1.31.0/go.mod",
+	},
+	"google.golang.org/protobuf@v1.28.1": {
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+	},
+	"google.golang.org/protobuf@v1.29.1": {
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+	},
+	"google.golang.org/protobuf@v1.30.0": {
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+	},
+	"google.golang.org/protobuf@v1.31.0": {
+		"github.com/golang/protobuf v1.5.0/go.mod",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+		"golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543/go.mod",
+		"google.golang.org/protobuf v1.26.0-rc.1/go.mod",
+	},
+	"google.golang.org/protobuf@v1.32.0": {
+		"github.com/google/go-cmp v0.5.5",
+		"github.com/google/go-cmp v0.5.5/go.mod",
+	},
+	"gopkg.in/check.v1@v1.0.0-20201130134442-10cb98267c6c": {
+		"github.com/kr/pretty v0.2.1/go.mod",
+		"github.com/kr/pty v1.1.1/go.mod",
+		"github.com/kr/text v0.1.0/go.mod",
+	},
+	"gopkg.in/yaml.v2@v2.4.0": {
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+	},
+	"gopkg.in/yaml.v3@v3.0.1": {
+		"gopkg.in/check.v1 v0.0.0-20161208181325-20d25e280405/go.mod",
+	},
+	"gorm.io/gorm@v1.25.5": {
+		"github.com/jinzhu/inflection v1.0.0/go.mod",
+		"github.com/jinzhu/now v1.1.5/go.mod",
+	},
+	"nhooyr.io/websocket@v1.8.10": {},
+}
+
+// moduleRequirements maps "module@version" to the requirements in its go.mod
+// (or, before module graph pruning, anywhere in its module graph) on modules
+// that indirectDependencies or the catalog list, at versions above the lowest
+// one they are listed at. These are the requirements that can make minimal
+// version selection pick a newer version of a module go.mod requires when
+// several dependencies are combined, as resolveModules does.
+var moduleRequirements = map[string]map[string]string{
+	"github.com/99designs/gqlgen@v0.17.45": {
+		"github.com/google/uuid":      "v1.6.0",
+		"github.com/mattn/go-isatty":  "v0.0.20",
+		"github.com/stretchr/testify": "v1.9.0",
+		"golang.org/x/mod":            "v0.16.0",
+		"golang.org/x/net":            "v0.22.0",
+		"golang.org/x/sys":            "v0.18.0",
+		"golang.org/x/text":           "v0.14.0",
+		"golang.org/x/tools":          "v0.19.0",
+		"google.golang.org/protobuf":  "v1.33.0",
+	},
+	"github.com/IBM/sarama@v1.42.2": {
+		"github.com/golang/snappy":      "v0.0.4",
+		"github.com/klauspost/compress": "v1.16.7",
+		"github.com/pierrec/lz4/v4":     "v4.1.21",
+		"github.com/stretchr/testify":   "v1.8.4",
+		"golang.org/x/crypto":           "v0.19.0",
+		"golang.org/x/net":              "v0.21.0",
+		"golang.org/x/sync":             "v0.6.0",
+	},
+	"github.com/Microsoft/go-winio@v0.6.1": {
+		"github.com/sirupsen/logrus": "v1.9.0",
+		"golang.org/x/sys":           "v0.5.0",
+		"golang.org/x/tools":         "v0.6.0",
+	},
+	"github.com/Microsoft/hcsshim@v0.11.4": {
+		"github.com/golang/groupcache":  "v0.0.0-20210331224755-41bb18bfe9da",
+		"github.com/golang/mock":        "v1.6.0",
+		"github.com/golang/protobuf":    "v1.5.3",
+		"github.com/google/go-cmp":      "v0.5.9",
+		"github.com/klauspost/compress": "v1.16.0",
+		"github.com/sirupsen/logrus":    "v1.9.0",
+		"go.opencensus.io":              "v0.24.0",
+		"golang.org/x/crypto":           "v0.1.0",
+		"golang.org/x/mod":              "v0.9.0",
+		"golang.org/x/net":              "v0.8.0",
+		"golang.org/x/sync":             "v0.1.0",
+		"golang.org/x/sys":              "v0.7.0",
+		"golang.org/x/text":             "v0.8.0",
+		"golang.org/x/tools":            "v0.7.0",
+		"google.golang.org/protobuf":    "v1.29.1",
+	},
+	"github.com/a-h/templ@v0.2.543": {
+		"github.com/google/go-cmp":   "v0.6.0",
+		"github.com/mattn/go-isatty": "v0.0.20",
+		"go.uber.org/atomic":         "v1.10.0",
+		"golang.org/x/net":           "v0.17.0",
+		"golang.org/x/sys":           "v0.14.0",
+	},
+	"github.com/apache/pulsar-client-go@v0.12.0": {
+		"github.com/klauspost/compress": "v1.14.4",
+		"golang.org/x/net":              "v0.17.0",
+		"golang.org/x/sys":              "v0.13.0",
+		"golang.org/x/term":             "v0.13.0",
+		"golang.org/x/text":             "v0.13.0",
+		"google.golang.org/protobuf":    "v1.30.0",
+	},
+	"github.com/aws/aws-sdk-go-v2@v1.24.1": {
+		"github.com/google/go-cmp": "v0.5.8",
+	},
+	"github.com/aws/aws-sdk-go-v2/internal/endpoints/v2@v2.5.10": {
+		"github.com/google/go-cmp": "v0.5.8",
+	},
+	"github.com/aws/aws-sdk-go-v2/service/sqs@v1.29.7": {
+		"github.com/google/go-cmp": "v0.5.8",
+	},
+	"github.com/aws/smithy-go@v1.19.0": {
+		"github.com/google/go-cmp": "v0.5.8",
+	},
+	"github.com/bytedance/sonic@v1.9.1": {
+		"github.com/stretchr/objx":    "v0.5.0",
+		"github.com/stretchr/testify": "v1.8.1",
+	},
+	"github.com/charmbracelet/bubbletea@v0.25.0": {
+		"golang.org/x/sync": "v0.1.0",
+		"golang.org/x/sys":  "v0.7.0",
+	},
+	"github.com/chenzhuoyu/base64x@v0.0.0-20221115062448-fe3a3abad311": {
+		"github.com/stretchr/objx":    "v0.5.0",
+		"github.com/stretchr/testify": "v1.8.1",
+	},
+	"github.com/confluentinc/confluent-kafka-go/v2@v2.3.0": {
+		"github.com/golang/groupcache": "v0.0.0-20210331224755-41bb18bfe9da",
+		"github.com/golang/protobuf":   "v1.5.3",
+		"github.com/google/uuid":       "v1.3.0",
+		"github.com/sirupsen/logrus":   "v1.8.1",
+		"github.com/stretchr/testify":  "v1.8.2",
+		"go.opencensus.io":             "v0.23.0",
+		"golang.org/x/net":             "v0.8.0",
+		"golang.org/x/sys":             "v0.6.0",
+		"google.golang.org/protobuf":   "v1.30.0",
+	},
+	"github.com/containerd/console@v1.0.4-0.20230313162750-1ae8d489ac81": {
+		"golang.org/x/sys": "v0.1.0",
+	},
+	"github.com/containerd/containerd@v1.7.12": {
+		"github.com/cespare/xxhash/v2":                     "v2.2.0",
+		"github.com/fsnotify/fsnotify":                     "v1.6.0",
+		"github.com/golang/groupcache":                     "v0.0.0-20210331224755-41bb18bfe9da",
+		"github.com/golang/protobuf":                       "v1.5.3",
+		"github.com/google/go-cmp":                         "v0.5.9",
+		"github.com/google/uuid":                           "v1.3.0",
+		"github.com/hashicorp/errwrap":                     "v1.1.0",
+		"github.com/klauspost/compress":                    "v1.16.0",
+		"github.com/matttproud/golang_protobuf_extensions": "v1.0.4",
+		"github.com/prometheus/client_golang":              "v1.14.0",
+		"github.com/prometheus/client_model":               "v0.3.0",
+		"github.com/prometheus/common":                     "v0.37.0",
+		"github.com/prometheus/procfs":                     "v0.8.0",
+		"github.com/sirupsen/logrus":                       "v1.9.3",
+		"github.com/stretchr/testify":                      "v1.8.4",
+		"go.opencensus.io":                                 "v0.24.0",
+		"go.opentelemetry.io/otel":                         "v1.19.0",
+		"go.opentelemetry.io/otel/metric":                  "v1.19.0",
+		"go.opentelemetry.io/otel/trace":                   "v1.19.0",
+		"golang.org/x/crypto":                              "v0.14.0",
+		"golang.org/x/mod":                                 "v0.11.0",
+		"golang.org/x/net":                                 "v0.17.0",
+		"golang.org/x/oauth2":                              "v0.10.0",
+		"golang.org/x/sync":                                "v0.3.0",
+		"golang.org/x/sys":                                 "v0.13.0",
+		"golang.org/x/term":                                "v0.13.0",
+		"golang.org/x/text":                                "v0.13.0",
+		"golang.org/x/time":                                "v0.0.0-20220210224613-90d013bbcef8",
+		"golang.org/x/tools":                               "v0.10.0",
+		"google.golang.org/protobuf":                       "v1.31.0",
+	},
+	"github.com/containerd/log@v0.1.0": {
+		"github.com/sirupsen/logrus":  "v1.9.3",
+		"github.com/stretchr/testify": "v1.8.2",
+		"golang.org/x/sys":            "v0.7.0",
+	},
+	"github.com/dgraph-io/badger/v4@v4.2.0": {
+		"github.com/cespare/xxhash/v2": "v2.2.0",
+		"github.com/golang/snappy":     "v0.0.3",
+		"golang.org/x/sys":             "v0.5.0",
+	},
+	"github.com/dgraph-io/ristretto@v0.1.1": {
+		"golang.org/x/sys": "v0.0.0-20221010170243-090e33056c14",
+	},
+	"github.com/docker/go-connections@v0.5.0": {
+		"golang.org/x/sys": "v0.1.0",
+	},
+	"github.com/eapache/go-xerial-snappy@v0.0.0-20230731223053-c322873962e3": {
+		"github.com/golang/snappy": "v0.0.4",
+	},
+	"github.com/fasthttp/websocket@v1.5.7": {
+		"github.com/klauspost/compress": "v1.17.3",
+		"github.com/valyala/fasthttp":   "v1.51.0",
+		"golang.org/x/net":              "v0.18.0",
+	},
+	"github.com/fsnotify/fsnotify@v1.6.0": {
+		"golang.org/x/sys": "v0.0.0-20220908164124-27713097b956",
+	},
+	"github.com/fsnotify/fsnotify@v1.7.0": {
+		"golang.org/x/sys": "v0.4.0",
+	},
+	"github.com/gabriel-vasile/mimetype@v1.4.2": {
+		"golang.org/x/net": "v0.8.0",
+	},
+	"github.com/gin-gonic/gin@v1.9.1": {
+		"github.com/mattn/go-isatty":  "v0.0.19",
+		"github.com/stretchr/testify": "v1.8.3",
+		"golang.org/x/crypto":         "v0.9.0",
+		"golang.org/x/net":            "v0.10.0",
+		"golang.org/x/sys":            "v0.8.0",
+		"golang.org/x/text":           "v0.9.0",
+		"google.golang.org/protobuf":  "v1.30.0",
+	},
+	"github.com/go-playground/validator/v10@v10.14.0": {
+		"golang.org/x/crypto": "v0.7.0",
+		"golang.org/x/net":    "v0.8.0",
+		"golang.org/x/sys":    "v0.6.0",
+		"golang.org/x/text":   "v0.8.0",
+	},
+	"github.com/gofiber/contrib/otelfiber@v1.0.10": {
+		"github.com/google/uuid":        "v1.3.0",
+		"github.com/klauspost/compress": "v1.16.3",
+		"github.com/mattn/go-isatty":    "v0.0.19",
+		"github.com/rivo/uniseg":        "v0.4.3",
+		"github.com/stretchr/testify":   "v1.8.4",
+		"golang.org/x/sys":              "v0.10.0",
+	},
+	"github.com/gofiber/contrib/websocket@v1.3.0": {
+		"github.com/gofiber/fiber/v2":   "v2.51.0",
+		"github.com/google/uuid":        "v1.4.0",
+		"github.com/klauspost/compress": "v1.17.3",
+		"github.com/mattn/go-isatty":    "v0.0.20",
+		"github.com/mattn/go-runewidth": "v0.0.15",
+		"github.com/stretchr/testify":   "v1.8.4",
+		"github.com/valyala/fasthttp":   "v1.51.0",
+		"golang.org/x/net":              "v0.18.0",
+		"golang.org/x/sys":              "v0.14.0",
+	},
+	"github.com/gofiber/fiber/v2@v2.48.0": {
+		"github.com/google/uuid":        "v1.3.0",
+		"github.com/klauspost/compress": "v1.16.3",
+		"github.com/mattn/go-isatty":    "v0.0.19",
+		"golang.org/x/sys":              "v0.10.0",
+	},
+	"github.com/gofiber/fiber/v2@v2.51.0": {
+		"github.com/google/uuid":        "v1.4.0",
+		"github.com/klauspost/compress": "v1.16.7",
+		"github.com/mattn/go-isatty":    "v0.0.20",
+		"github.com/mattn/go-runewidth": "v0.0.15",
+		"github.com/valyala/fasthttp":   "v1.50.0",
+		"golang.org/x/sys":              "v0.14.0",
+	},
+	"github.com/gofiber/fiber/v2@v2.52.0": {
+		"github.com/google/uuid":        "v1.5.0",
+		"github.com/klauspost/compress": "v1.17.0",
+		"github.com/mattn/go-isatty":    "v0.0.20",
+		"github.com/mattn/go-runewidth": "v0.0.15",
+		"github.com/valyala/fasthttp":   "v1.51.0",
+		"golang.org/x/sys":              "v0.15.0",
+	},
+	"github.com/golang-migrate/migrate/v4@v4.17.0": {
+		"github.com/Azure/go-ansiterm":              "v0.0.0-20230124172434-306776ec8161",
+		"github.com/cespare/xxhash/v2":              "v2.2.0",
+		"github.com/golang/groupcache":              "v0.0.0-20210331224755-41bb18bfe9da",
+		"github.com/golang/protobuf":                "v1.5.3",
+		"github.com/golang/snappy":                  "v0.0.4",
+		"github.com/google/flatbuffers":             "v2.0.8+incompatible",
+		"github.com/google/uuid":                    "v1.4.0",
+		"github.com/hashicorp/errwrap":              "v1.1.0",
+		"github.com/klauspost/compress":             "v1.15.11",
+		"github.com/pierrec/lz4/v4":                 "v4.1.16",
+		"github.com/sirupsen/logrus":                "v1.9.2",
+		"github.com/stretchr/testify":               "v1.8.3",
+		"go.opencensus.io":                          "v0.24.0",
+		"golang.org/x/crypto":                       "v0.17.0",
+		"golang.org/x/mod":                          "v0.11.0",
+		"golang.org/x/net":                          "v0.18.0",
+		"golang.org/x/oauth2":                       "v0.14.0",
+		"golang.org/x/sync":                         "v0.5.0",
+		"golang.org/x/sys":                          "v0.15.0",
+		"golang.org/x/term":                         "v0.15.0",
+		"golang.org/x/text":                         "v0.14.0",
+		"golang.org/x/time":                         "v0.3.0",
+		"golang.org/x/tools":                        "v0.10.0",
+		"google.golang.org/genproto/googleapis/api": "v0.0.0-20231016165738-49dd2c1f3d0b",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20231030173426-d783a09b4405",
+		"google.golang.org/grpc":                    "v1.59.0",
+		"google.golang.org/protobuf":                "v1.31.0",
+	},
+	"github.com/golang/protobuf@v1.5.2": {
+		"github.com/google/go-cmp": "v0.5.5",
+	},
+	"github.com/golang/protobuf@v1.5.3": {
+		"github.com/google/go-cmp": "v0.5.5",
+	},
+	"github.com/gorilla/websocket@v1.5.1": {
+		"golang.org/x/net": "v0.17.0",
+	},
+	"github.com/grpc-ecosystem/grpc-gateway/v2@v2.16.0": {
+		"github.com/golang/glog":     "v1.1.0",
+		"github.com/golang/protobuf": "v1.5.3",
+		"github.com/google/go-cmp":   "v0.5.9",
+		"github.com/kr/pretty":       "v0.3.1",
+		"golang.org/x/net":           "v0.10.0",
+		"golang.org/x/oauth2":        "v0.8.0",
+		"golang.org/x/sys":           "v0.8.0",
+		"golang.org/x/text":          "v0.9.0",
+		"google.golang.org/protobuf": "v1.30.0",
+	},
+	"github.com/jackc/pgx/v5@v5.5.1": {
+		"github.com/stretchr/testify": "v1.8.1",
+		"golang.org/x/crypto":         "v0.9.0",
+		"golang.org/x/sync":           "v0.1.0",
+		"golang.org/x/text":           "v0.9.0",
+	},
+	"github.com/jackc/puddle/v2@v2.2.1": {
+		"github.com/stretchr/testify": "v1.8.1",
+		"golang.org/x/sync":           "v0.1.0",
+	},
+	"github.com/jcmturner/gokrb5/v8@v8.4.4": {
+		"github.com/stretchr/objx":    "v0.5.0",
+		"github.com/stretchr/testify": "v1.8.1",
+		"golang.org/x/crypto":         "v0.6.0",
+		"golang.org/x/sys":            "v0.5.0",
+		"golang.org/x/text":           "v0.7.0",
+	},
+	"github.com/klauspost/compress@v1.12.3": {
+		"github.com/golang/snappy": "v0.0.3",
+	},
+	"github.com/kr/pretty@v0.3.1": {
+		"github.com/rogpeppe/go-internal": "v1.9.0",
+	},
+	"github.com/labstack/echo/v4@v4.11.4": {
+		"github.com/golang-jwt/jwt":   "v3.2.2+incompatible",
+		"github.com/mattn/go-isatty":  "v0.0.20",
+		"github.com/stretchr/testify": "v1.8.4",
+		"golang.org/x/crypto":         "v0.17.0",
+		"golang.org/x/net":            "v0.19.0",
+		"golang.org/x/sys":            "v0.15.0",
+		"golang.org/x/text":           "v0.14.0",
+		"golang.org/x/time":           "v0.5.0",
+	},
+	"github.com/labstack/gommon@v0.4.2": {
+		"github.com/mattn/go-isatty":  "v0.0.20",
+		"github.com/stretchr/testify": "v1.8.4",
+		"golang.org/x/sys":            "v0.15.0",
+	},
+	"github.com/leodido/go-urn@v1.2.4": {
+		"github.com/stretchr/objx":    "v0.5.0",
+		"github.com/stretchr/testify": "v1.8.2",
+	},
+	"github.com/lufia/plan9stats@v0.0.0-20211012122336-39d0f177ccd0": {
+		"github.com/google/go-cmp": "v0.5.6",
+	},
+	"github.com/mattn/go-colorable@v0.1.13": {
+		"golang.org/x/sys": "v0.0.0-20220811171246-fbc7d0a398ab",
+	},
+	"github.com/mattn/go-isatty@v0.0.18": {
+		"golang.org/x/sys": "v0.6.0",
+	},
+	"github.com/mattn/go-isatty@v0.0.19": {
+		"golang.org/x/sys": "v0.6.0",
+	},
+	"github.com/mattn/go-isatty@v0.0.20": {
+		"golang.org/x/sys": "v0.6.0",
+	},
+	"github.com/matttproud/golang_protobuf_extensions/v2@v2.0.0": {
+		"github.com/google/go-cmp": "v0.5.9",
+	},
+	"github.com/moby/sys/user@v0.1.0": {
+		"golang.org/x/sys": "v0.1.0",
+	},
+	"github.com/moby/term@v0.5.0": {
+		"golang.org/x/sys": "v0.1.0",
+	},
+	"github.com/muesli/termenv@v0.15.2": {
+		"golang.org/x/sys": "v0.7.0",
+	},
+	"github.com/nats-io/nats.go@v1.31.0": {
+		"github.com/klauspost/compress": "v1.17.0",
+		"golang.org/x/crypto":           "v0.6.0",
+		"golang.org/x/sys":              "v0.5.0",
+		"golang.org/x/text":             "v0.13.0",
+	},
+	"github.com/nats-io/nkeys@v0.4.5": {
+		"golang.org/x/crypto": "v0.6.0",
+		"golang.org/x/sys":    "v0.5.0",
+	},
+	"github.com/nats-io/nkeys@v0.4.6": {
+		"golang.org/x/crypto": "v0.14.0",
+		"golang.org/x/sys":    "v0.13.0",
+	},
+	"github.com/onsi/ginkgo/v2@v2.15.0": {
+		"github.com/go-logr/logr":    "v1.3.0",
+		"github.com/golang/protobuf": "v1.5.3",
+		"github.com/google/go-cmp":   "v0.6.0",
+		"github.com/onsi/gomega":     "v1.30.0",
+		"golang.org/x/net":           "v0.19.0",
+		"golang.org/x/sys":           "v0.15.0",
+		"golang.org/x/text":          "v0.14.0",
+		"golang.org/x/tools":         "v0.16.1",
+	},
+	"github.com/onsi/gomega@v1.30.0": {
+		"github.com/golang/protobuf": "v1.5.3",
+		"github.com/google/go-cmp":   "v0.6.0",
+		"golang.org/x/net":           "v0.17.0",
+		"golang.org/x/sys":           "v0.13.0",
+		"golang.org/x/text":          "v0.13.0",
+		"golang.org/x/tools":         "v0.12.0",
+	},
+	"github.com/pelletier/go-toml/v2@v2.0.8": {
+		"github.com/stretchr/objx":    "v0.5.0",
+		"github.com/stretchr/testify": "v1.8.3",
+	},
+	"github.com/pelletier/go-toml/v2@v2.1.0": {
+		"github.com/stretchr/objx":    "v0.5.0",
+		"github.com/stretchr/testify": "v1.8.4",
+	},
+	"github.com/prometheus/client_golang@v1.11.1": {
+		"github.com/google/go-cmp": "v0.5.5",
+	},
+	"github.com/prometheus/client_golang@v1.12.1": {
+		"github.com/cespare/xxhash/v2": "v2.1.2",
+		"github.com/golang/groupcache": "v0.0.0-20200121045136-8c9f03a8e57e",
+		"github.com/google/go-cmp":     "v0.5.5",
+		"github.com/prometheus/common": "v0.32.1",
+		"github.com/prometheus/procfs": "v0.7.3",
+		"golang.org/x/oauth2":          "v0.0.0-20210514164344-f6687ab2804c",
+	},
+	"github.com/prometheus/client_golang@v1.14.0": {
+		"github.com/cespare/xxhash/v2":       "v2.1.2",
+		"github.com/prometheus/client_model": "v0.3.0",
+		"github.com/prometheus/common":       "v0.37.0",
+		"github.com/prometheus/procfs":       "v0.8.0",
+		"golang.org/x/oauth2":                "v0.0.0-20220223155221-ee480838109b",
+	},
+	"github.com/prometheus/client_golang@v1.15.1": {
+		"github.com/cespare/xxhash/v2":                     "v2.2.0",
+		"github.com/golang/protobuf":                       "v1.5.3",
+		"github.com/kr/pretty":                             "v0.3.1",
+		"github.com/matttproud/golang_protobuf_extensions": "v1.0.4",
+		"github.com/prometheus/client_model":               "v0.3.0",
+		"github.com/prometheus/common":                     "v0.42.0",
+		"github.com/prometheus/procfs":                     "v0.9.0",
+		"golang.org/x/oauth2":                              "v0.5.0",
+		"golang.org/x/sys":                                 "v0.6.0",
+		"golang.org/x/text":                                "v0.7.0",
+		"google.golang.org/protobuf":                       "v1.30.0",
+	},
+	"github.com/prometheus/client_golang@v1.17.0": {
+		"github.com/cespare/xxhash/v2":                     "v2.2.0",
+		"github.com/golang/protobuf":                       "v1.5.3",
+		"github.com/kr/pretty":                             "v0.3.1",
+		"github.com/matttproud/golang_protobuf_extensions": "v1.0.4",
+		"github.com/prometheus/client_model":               "v0.4.1-0.20230718164431-9a2bf3000d16",
+		"github.com/prometheus/common":                     "v0.44.0",
+		"github.com/prometheus/procfs":                     "v0.11.1",
+		"golang.org/x/net":                                 "v0.10.0",
+		"golang.org/x/oauth2":                              "v0.8.0",
+		"golang.org/x/sys":                                 "v0.11.0",
+		"golang.org/x/text":                                "v0.9.0",
+		"google.golang.org/protobuf":                       "v1.31.0",
+	},
+	"github.com/prometheus/client_golang@v1.18.0": {
+		"github.com/cespare/xxhash/v2":       "v2.2.0",
+		"github.com/golang/protobuf":         "v1.5.3",
+		"github.com/kr/pretty":               "v0.3.1",
+		"github.com/prometheus/client_model": "v0.5.0",
+		"github.com/prometheus/common":       "v0.45.0",
+		"github.com/prometheus/procfs":       "v0.12.0",
+		"golang.org/x/net":                   "v0.17.0",
+		"golang.org/x/oauth2":                "v0.12.0",
+		"golang.org/x/sys":                   "v0.15.0",
+		"golang.org/x/text":                  "v0.13.0",
+		"google.golang.org/protobuf":         "v1.31.0",
+	},
+	"github.com/prometheus/client_model@v0.4.0": {
+		"google.golang.org/protobuf": "v1.30.0",
+	},
+	"github.com/prometheus/client_model@v0.4.1-0.20230718164431-9a2bf3000d16": {
+		"google.golang.org/protobuf": "v1.30.0",
+	},
+	"github.com/prometheus/client_model@v0.5.0": {
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"github.com/prometheus/common@v0.32.1": {
+		"github.com/golang/groupcache": "v0.0.0-20200121045136-8c9f03a8e57e",
+		"github.com/google/go-cmp":     "v0.5.5",
+		"golang.org/x/oauth2":          "v0.0.0-20210514164344-f6687ab2804c",
+	},
+	"github.com/prometheus/common@v0.37.0": {
+		"github.com/cespare/xxhash/v2":        "v2.1.2",
+		"github.com/golang/groupcache":        "v0.0.0-20200121045136-8c9f03a8e57e",
+		"github.com/google/go-cmp":            "v0.5.5",
+		"github.com/prometheus/client_golang": "v1.12.1",
+		"github.com/prometheus/common":        "v0.32.1",
+		"github.com/prometheus/procfs":        "v0.7.3",
+		"golang.org/x/oauth2":                 "v0.0.0-20220223155221-ee480838109b",
+	},
+	"github.com/prometheus/common@v0.42.0": {
+		"github.com/cespare/xxhash/v2":                     "v2.1.2",
+		"github.com/matttproud/golang_protobuf_extensions": "v1.0.4",
+		"github.com/prometheus/client_golang":              "v1.14.0",
+		"github.com/prometheus/client_model":               "v0.3.0",
+		"github.com/prometheus/procfs":                     "v0.8.0",
+		"golang.org/x/oauth2":                              "v0.5.0",
+		"golang.org/x/sys":                                 "v0.5.0",
+		"golang.org/x/text":                                "v0.7.0",
+	},
+	"github.com/prometheus/common@v0.44.0": {
+		"github.com/cespare/xxhash/v2":                     "v2.2.0",
+		"github.com/golang/protobuf":                       "v1.5.3",
+		"github.com/matttproud/golang_protobuf_extensions": "v1.0.4",
+		"github.com/prometheus/client_golang":              "v1.15.1",
+		"github.com/prometheus/client_model":               "v0.4.0",
+		"github.com/prometheus/procfs":                     "v0.9.0",
+		"github.com/rogpeppe/go-internal":                  "v1.10.0",
+		"golang.org/x/net":                                 "v0.10.0",
+		"golang.org/x/oauth2":                              "v0.8.0",
+		"golang.org/x/sys":                                 "v0.8.0",
+		"golang.org/x/text":                                "v0.9.0",
+		"google.golang.org/protobuf":                       "v1.30.0",
+	},
+	"github.com/prometheus/common@v0.45.0": {
+		"github.com/cespare/xxhash/v2":        "v2.2.0",
+		"github.com/golang/protobuf":          "v1.5.3",
+		"github.com/prometheus/client_golang": "v1.17.0",
+		"github.com/prometheus/client_model":  "v0.4.1-0.20230718164431-9a2bf3000d16",
+		"github.com/prometheus/procfs":        "v0.11.1",
+		"github.com/rogpeppe/go-internal":     "v1.10.0",
+		"golang.org/x/net":                    "v0.17.0",
+		"golang.org/x/oauth2":                 "v0.12.0",
+		"golang.org/x/sys":                    "v0.13.0",
+		"golang.org/x/text":                   "v0.13.0",
+		"google.golang.org/protobuf":          "v1.31.0",
+	},
+	"github.com/prometheus/procfs@v0.6.0": {
+		"github.com/google/go-cmp": "v0.5.4",
+	},
+	"github.com/prometheus/procfs@v0.7.3": {
+		"github.com/google/go-cmp": "v0.5.4",
+	},
+	"github.com/prometheus/procfs@v0.8.0": {
+		"github.com/google/go-cmp": "v0.5.8",
+	},
+	"github.com/prometheus/procfs@v0.9.0": {
+		"github.com/google/go-cmp": "v0.5.9",
+		"golang.org/x/sync":        "v0.1.0",
+		"golang.org/x/sys":         "v0.3.0",
+	},
+	"github.com/prometheus/procfs@v0.11.1": {
+		"github.com/google/go-cmp": "v0.5.9",
+		"golang.org/x/sync":        "v0.3.0",
+		"golang.org/x/sys":         "v0.9.0",
+	},
+	"github.com/prometheus/procfs@v0.12.0": {
+		"github.com/google/go-cmp": "v0.5.9",
+		"golang.org/x/sync":        "v0.3.0",
+		"golang.org/x/sys":         "v0.12.0",
+	},
+	"github.com/redis/go-redis/v9@v9.4.0": {
+		"github.com/cespare/xxhash/v2": "v2.2.0",
+	},
+	"github.com/rs/zerolog@v1.32.0": {
+		"github.com/mattn/go-isatty": "v0.0.19",
+		"golang.org/x/sys":           "v0.12.0",
+	},
+	"github.com/sagikazarmark/locafero@v0.4.0": {
+		"github.com/stretchr/testify": "v1.8.4",
+		"golang.org/x/text":           "v0.14.0",
+	},
+	"github.com/sagikazarmark/slog-shim@v0.1.0": {
+		"golang.org/x/exp": "v0.0.0-20230905200255-921286631fa9",
+	},
+	"github.com/savsgio/gotils@v0.0.0-20230208104028-c358bd845dee": {
+		"github.com/google/uuid": "v1.3.0",
+	},
+	"github.com/segmentio/kafka-go@v0.4.47": {
+		"github.com/klauspost/compress": "v1.15.9",
+		"golang.org/x/crypto":           "v0.14.0",
+		"golang.org/x/net":              "v0.17.0",
+		"golang.org/x/sync":             "v0.1.0",
+		"golang.org/x/sys":              "v0.13.0",
+		"golang.org/x/term":             "v0.13.0",
+		"golang.org/x/text":             "v0.13.0",
+		"golang.org/x/tools":            "v0.6.0",
+	},
+	"github.com/shirou/gopsutil/v3@v3.23.12": {
+		"github.com/google/go-cmp":    "v0.6.0",
+		"github.com/stretchr/objx":    "v0.5.0",
+		"github.com/stretchr/testify": "v1.8.4",
+		"golang.org/x/sys":            "v0.15.0",
+	},
+	"github.com/shoenig/go-m1cpu@v0.1.6": {
+		"github.com/google/go-cmp": "v0.5.9",
+	},
+	"github.com/sourcegraph/conc@v0.3.0": {
+		"github.com/rogpeppe/go-internal": "v1.9.0",
+		"github.com/stretchr/testify":     "v1.8.1",
+	},
+	"github.com/spf13/afero@v1.11.0": {
+		"github.com/golang/groupcache":              "v0.0.0-20210331224755-41bb18bfe9da",
+		"github.com/golang/protobuf":                "v1.5.3",
+		"github.com/google/uuid":                    "v1.4.0",
+		"go.opencensus.io":                          "v0.24.0",
+		"golang.org/x/crypto":                       "v0.16.0",
+		"golang.org/x/net":                          "v0.19.0",
+		"golang.org/x/oauth2":                       "v0.15.0",
+		"golang.org/x/sync":                         "v0.5.0",
+		"golang.org/x/sys":                          "v0.15.0",
+		"golang.org/x/text":                         "v0.14.0",
+		"golang.org/x/time":                         "v0.5.0",
+		"google.golang.org/genproto/googleapis/api": "v0.0.0-20231106174013-bbf56f31fb17",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20231120223509-83a465c0220f",
+		"google.golang.org/grpc":                    "v1.59.0",
+		"google.golang.org/protobuf":                "v1.31.0",
+	},
+	"github.com/spf13/cast@v1.6.0": {
+		"github.com/google/go-cmp":        "v0.5.9",
+		"github.com/kr/pretty":            "v0.3.1",
+		"github.com/rogpeppe/go-internal": "v1.9.0",
+	},
+	"github.com/spf13/cobra@v1.8.0": {
+		"github.com/cpuguy83/go-md2man/v2":     "v2.0.3",
+		"github.com/inconshreveable/mousetrap": "v1.1.0",
+	},
+	"github.com/spf13/viper@v1.18.2": {
+		"github.com/davecgh/go-spew":                "v1.1.2-0.20180830191138-d8f796af33cc",
+		"github.com/fsnotify/fsnotify":              "v1.7.0",
+		"github.com/golang/groupcache":              "v0.0.0-20210331224755-41bb18bfe9da",
+		"github.com/golang/protobuf":                "v1.5.3",
+		"github.com/klauspost/compress":             "v1.17.0",
+		"github.com/nats-io/nkeys":                  "v0.4.6",
+		"github.com/pelletier/go-toml/v2":           "v2.1.0",
+		"github.com/pmezard/go-difflib":             "v1.0.1-0.20181226105442-5d4384ee4fb2",
+		"github.com/stretchr/testify":               "v1.8.4",
+		"go.opencensus.io":                          "v0.24.0",
+		"go.uber.org/atomic":                        "v1.9.0",
+		"golang.org/x/crypto":                       "v0.16.0",
+		"golang.org/x/exp":                          "v0.0.0-20230905200255-921286631fa9",
+		"golang.org/x/net":                          "v0.19.0",
+		"golang.org/x/oauth2":                       "v0.15.0",
+		"golang.org/x/sync":                         "v0.5.0",
+		"golang.org/x/sys":                          "v0.15.0",
+		"golang.org/x/text":                         "v0.14.0",
+		"golang.org/x/time":                         "v0.5.0",
+		"google.golang.org/genproto/googleapis/api": "v0.0.0-20231106174013-bbf56f31fb17",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20231120223509-83a465c0220f",
+		"google.golang.org/grpc":                    "v1.59.0",
+		"google.golang.org/protobuf":                "v1.31.0",
+	},
+	"github.com/stretchr/objx@v0.5.2": {
+		"github.com/stretchr/testify": "v1.8.4",
+	},
+	"github.com/stretchr/testify@v1.8.1": {
+		"github.com/stretchr/objx": "v0.5.0",
+	},
+	"github.com/stretchr/testify@v1.8.2": {
+		"github.com/stretchr/objx": "v0.5.0",
+	},
+	"github.com/stretchr/testify@v1.8.3": {
+		"github.com/stretchr/objx": "v0.5.0",
+	},
+	"github.com/stretchr/testify@v1.8.4": {
+		"github.com/stretchr/objx": "v0.5.0",
+	},
+	"github.com/stretchr/testify@v1.9.0": {
+		"github.com/stretchr/objx": "v0.5.2",
+	},
+	"github.com/subosito/gotenv@v1.6.0": {
+		"golang.org/x/text": "v0.12.0",
+	},
+	"github.com/swaggo/http-swagger@v1.3.4": {
+		"golang.org/x/sys": "v0.5.0",
+	},
+	"github.com/testcontainers/testcontainers-go@v0.28.0": {
+		"github.com/golang/protobuf":      "v1.5.3",
+		"github.com/google/uuid":          "v1.6.0",
+		"github.com/klauspost/compress":   "v1.16.0",
+		"github.com/sirupsen/logrus":      "v1.9.3",
+		"github.com/stretchr/testify":     "v1.8.4",
+		"go.opentelemetry.io/otel":        "v1.19.0",
+		"go.opentelemetry.io/otel/metric": "v1.19.0",
+		"go.opentelemetry.io/otel/trace":  "v1.19.0",
+		"golang.org/x/mod":                "v0.11.0",
+		"golang.org/x/sys":                "v0.16.0",
+		"golang.org/x/time":               "v0.3.0",
+		"golang.org/x/tools":              "v0.10.0",
+		"google.golang.org/protobuf":      "v1.31.0",
+	},
+	"github.com/tklauser/go-sysconf@v0.3.12": {
+		"golang.org/x/sys": "v0.11.0",
+	},
+	"github.com/tklauser/numcpus@v0.6.1": {
+		"golang.org/x/sys": "v0.8.0",
+	},
+	"github.com/twmb/franz-go@v1.15.4": {
+		"github.com/klauspost/compress": "v1.16.7",
+		"github.com/pierrec/lz4/v4":     "v4.1.19",
+		"golang.org/x/crypto":           "v0.17.0",
+	},
+	"github.com/valyala/fasthttp@v1.48.0": {
+		"github.com/klauspost/compress": "v1.16.3",
+		"golang.org/x/crypto":           "v0.7.0",
+		"golang.org/x/net":              "v0.8.0",
+		"golang.org/x/sys":              "v0.6.0",
+		"golang.org/x/text":             "v0.8.0",
+	},
+	"github.com/valyala/fasthttp@v1.50.0": {
+		"github.com/klauspost/compress": "v1.16.3",
+		"golang.org/x/crypto":           "v0.7.0",
+		"golang.org/x/net":              "v0.8.0",
+		"golang.org/x/sys":              "v0.6.0",
+		"golang.org/x/text":             "v0.8.0",
+	},
+	"github.com/valyala/fasthttp@v1.51.0": {
+		"github.com/klauspost/compress": "v1.17.0",
+		"golang.org/x/crypto":           "v0.14.0",
+		"golang.org/x/net":              "v0.17.0",
+		"golang.org/x/sys":              "v0.13.0",
+		"golang.org/x/text":             "v0.13.0",
+	},
+	"github.com/xdg-go/scram@v1.1.2": {
+		"golang.org/x/sys": "v0.0.0-20220722155257-8c9f86f7a55f",
+	},
+	"github.com/xdg-go/stringprep@v1.0.4": {
+		"golang.org/x/sys": "v0.0.0-20220722155257-8c9f86f7a55f",
+	},
+	"go.mongodb.org/mongo-driver@v1.13.1": {
+		"github.com/klauspost/compress": "v1.13.6",
+		"golang.org/x/sys":              "v0.0.0-20220722155257-8c9f86f7a55f",
+		"golang.org/x/text":             "v0.7.0",
+	},
+	"go.opencensus.io@v0.23.0": {
+		"github.com/golang/groupcache": "v0.0.0-20200121045136-8c9f03a8e57e",
+		"github.com/google/go-cmp":     "v0.5.3",
+	},
+	"go.opencensus.io@v0.24.0": {
+		"github.com/golang/groupcache": "v0.0.0-20200121045136-8c9f03a8e57e",
+		"github.com/google/go-cmp":     "v0.5.3",
+		"github.com/stretchr/objx":     "v0.5.0",
+		"github.com/stretchr/testify":  "v1.8.1",
+	},
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp@v0.45.0": {
+		"github.com/stretchr/testify":     "v1.8.4",
+		"go.opentelemetry.io/otel":        "v1.19.0",
+		"go.opentelemetry.io/otel/metric": "v1.19.0",
+		"go.opentelemetry.io/otel/trace":  "v1.19.0",
+	},
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp@v0.47.0": {
+		"github.com/felixge/httpsnoop":    "v1.0.4",
+		"github.com/go-logr/logr":         "v1.4.1",
+		"github.com/stretchr/testify":     "v1.8.4",
+		"go.opentelemetry.io/otel":        "v1.22.0",
+		"go.opentelemetry.io/otel/metric": "v1.22.0",
+		"go.opentelemetry.io/otel/trace":  "v1.22.0",
+	},
+	"go.opentelemetry.io/otel@v1.16.0": {
+		"github.com/google/go-cmp":    "v0.5.9",
+		"github.com/stretchr/testify": "v1.8.3",
+	},
+	"go.opentelemetry.io/otel@v1.19.0": {
+		"github.com/google/go-cmp":        "v0.5.9",
+		"github.com/stretchr/testify":     "v1.8.4",
+		"go.opentelemetry.io/otel/metric": "v1.19.0",
+		"go.opentelemetry.io/otel/trace":  "v1.19.0",
+	},
+	"go.opentelemetry.io/otel@v1.22.0": {
+		"github.com/go-logr/logr":         "v1.4.1",
+		"github.com/google/go-cmp":        "v0.6.0",
+		"github.com/stretchr/testify":     "v1.8.4",
+		"go.opentelemetry.io/otel/metric": "v1.22.0",
+		"go.opentelemetry.io/otel/trace":  "v1.22.0",
+	},
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace@v1.22.0": {
+		"github.com/go-logr/logr":         "v1.4.1",
+		"github.com/google/go-cmp":        "v0.6.0",
+		"github.com/kr/pretty":            "v0.3.1",
+		"github.com/rogpeppe/go-internal": "v1.10.0",
+		"github.com/stretchr/testify":     "v1.8.4",
+		"go.opentelemetry.io/otel":        "v1.22.0",
+		"go.opentelemetry.io/otel/metric": "v1.22.0",
+		"go.opentelemetry.io/otel/trace":  "v1.22.0",
+		"golang.org/x/sys":                "v0.16.0",
+		"google.golang.org/protobuf":      "v1.32.0",
+	},
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp@v1.22.0": {
+		"github.com/go-logr/logr":                   "v1.4.1",
+		"github.com/golang/protobuf":                "v1.5.3",
+		"github.com/stretchr/testify":               "v1.8.4",
+		"go.opentelemetry.io/otel":                  "v1.22.0",
+		"go.opentelemetry.io/otel/metric":           "v1.22.0",
+		"go.opentelemetry.io/otel/trace":            "v1.22.0",
+		"golang.org/x/net":                          "v0.17.0",
+		"golang.org/x/sys":                          "v0.16.0",
+		"golang.org/x/text":                         "v0.13.0",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20231002182017-d307bd883b97",
+		"google.golang.org/grpc":                    "v1.60.1",
+		"google.golang.org/protobuf":                "v1.32.0",
+	},
+	"go.opentelemetry.io/otel/metric@v1.16.0": {
+		"github.com/stretchr/testify": "v1.8.3",
+	},
+	"go.opentelemetry.io/otel/metric@v1.19.0": {
+		"github.com/stretchr/testify":    "v1.8.4",
+		"go.opentelemetry.io/otel":       "v1.19.0",
+		"go.opentelemetry.io/otel/trace": "v1.19.0",
+	},
+	"go.opentelemetry.io/otel/metric@v1.22.0": {
+		"github.com/go-logr/logr":        "v1.4.1",
+		"github.com/stretchr/testify":    "v1.8.4",
+		"go.opentelemetry.io/otel":       "v1.22.0",
+		"go.opentelemetry.io/otel/trace": "v1.22.0",
+	},
+	"go.opentelemetry.io/otel/sdk@v1.22.0": {
+		"github.com/go-logr/logr":         "v1.4.1",
+		"github.com/google/go-cmp":        "v0.6.0",
+		"github.com/stretchr/testify":     "v1.8.4",
+		"go.opentelemetry.io/otel":        "v1.22.0",
+		"go.opentelemetry.io/otel/metric": "v1.22.0",
+		"go.opentelemetry.io/otel/trace":  "v1.22.0",
+		"golang.org/x/sys":                "v0.16.0",
+	},
+	"go.opentelemetry.io/otel/trace@v1.16.0": {
+		"github.com/google/go-cmp":    "v0.5.9",
+		"github.com/stretchr/testify": "v1.8.3",
+	},
+	"go.opentelemetry.io/otel/trace@v1.19.0": {
+		"github.com/google/go-cmp":    "v0.5.9",
+		"github.com/stretchr/testify": "v1.8.4",
+		"go.opentelemetry.io/otel":    "v1.19.0",
+	},
+	"go.opentelemetry.io/otel/trace@v1.22.0": {
+		"github.com/google/go-cmp":    "v0.6.0",
+		"github.com/stretchr/testify": "v1.8.4",
+		"go.opentelemetry.io/otel":    "v1.22.0",
+	},
+	"go.opentelemetry.io/proto/otlp@v1.0.0": {
+		"github.com/golang/protobuf": "v1.5.3",
+		"golang.org/x/net":           "v0.10.0",
+		"golang.org/x/sys":           "v0.8.0",
+		"golang.org/x/text":          "v0.9.0",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"go.uber.org/mock@v0.4.0": {
+		"golang.org/x/mod":   "v0.11.0",
+		"golang.org/x/sys":   "v0.1.0",
+		"golang.org/x/tools": "v0.2.0",
+	},
+	"go.uber.org/zap@v1.26.0": {
+		"github.com/stretchr/testify": "v1.8.1",
+		"go.uber.org/multierr":        "v1.10.0",
+	},
+	"golang.org/x/crypto@v0.1.0": {
+		"golang.org/x/sys":  "v0.1.0",
+		"golang.org/x/text": "v0.4.0",
+	},
+	"golang.org/x/crypto@v0.6.0": {
+		"golang.org/x/sys":  "v0.5.0",
+		"golang.org/x/text": "v0.7.0",
+	},
+	"golang.org/x/crypto@v0.7.0": {
+		"golang.org/x/net":  "v0.8.0",
+		"golang.org/x/sys":  "v0.6.0",
+		"golang.org/x/text": "v0.8.0",
+	},
+	"golang.org/x/crypto@v0.9.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.8.0",
+		"golang.org/x/term": "v0.8.0",
+		"golang.org/x/text": "v0.9.0",
+	},
+	"golang.org/x/crypto@v0.10.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.9.0",
+		"golang.org/x/term": "v0.9.0",
+		"golang.org/x/text": "v0.10.0",
+	},
+	"golang.org/x/crypto@v0.11.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.10.0",
+		"golang.org/x/term": "v0.10.0",
+		"golang.org/x/text": "v0.11.0",
+	},
+	"golang.org/x/crypto@v0.12.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.11.0",
+		"golang.org/x/term": "v0.11.0",
+		"golang.org/x/text": "v0.12.0",
+	},
+	"golang.org/x/crypto@v0.13.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.12.0",
+		"golang.org/x/term": "v0.12.0",
+		"golang.org/x/text": "v0.13.0",
+	},
+	"golang.org/x/crypto@v0.14.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.13.0",
+		"golang.org/x/term": "v0.13.0",
+		"golang.org/x/text": "v0.13.0",
+	},
+	"golang.org/x/crypto@v0.15.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.14.0",
+		"golang.org/x/term": "v0.14.0",
+		"golang.org/x/text": "v0.14.0",
+	},
+	"golang.org/x/crypto@v0.16.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.15.0",
+		"golang.org/x/term": "v0.15.0",
+		"golang.org/x/text": "v0.14.0",
+	},
+	"golang.org/x/crypto@v0.17.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.15.0",
+		"golang.org/x/term": "v0.15.0",
+		"golang.org/x/text": "v0.14.0",
+	},
+	"golang.org/x/crypto@v0.18.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.16.0",
+		"golang.org/x/term": "v0.16.0",
+		"golang.org/x/text": "v0.14.0",
+	},
+	"golang.org/x/crypto@v0.19.0": {
+		"golang.org/x/net":  "v0.10.0",
+		"golang.org/x/sys":  "v0.17.0",
+		"golang.org/x/term": "v0.17.0",
+		"golang.org/x/text": "v0.14.0",
+	},
+	"golang.org/x/crypto@v0.21.0": {
+		"golang.org/x/net":  "v0.21.0",
+		"golang.org/x/sys":  "v0.18.0",
+		"golang.org/x/term": "v0.18.0",
+		"golang.org/x/text": "v0.14.0",
+	},
+	"golang.org/x/exp@v0.0.0-20230510235704-dd950f8aeaea": {
+		"github.com/google/go-cmp": "v0.5.8",
+		"golang.org/x/sys":         "v0.1.0",
+		"golang.org/x/tools":       "v0.2.0",
+	},
+	"golang.org/x/exp@v0.0.0-20230905200255-921286631fa9": {
+		"github.com/google/go-cmp": "v0.5.8",
+		"golang.org/x/mod":         "v0.12.0",
+		"golang.org/x/sys":         "v0.12.0",
+		"golang.org/x/tools":       "v0.13.0",
+	},
+	"golang.org/x/mod@v0.14.0": {
+		"golang.org/x/tools": "v0.13.0",
+	},
+	"golang.org/x/mod@v0.16.0": {
+		"golang.org/x/tools": "v0.13.0",
+	},
+	"golang.org/x/net@v0.7.0": {
+		"golang.org/x/sys":  "v0.5.0",
+		"golang.org/x/text": "v0.7.0",
+	},
+	"golang.org/x/net@v0.8.0": {
+		"golang.org/x/sys":  "v0.6.0",
+		"golang.org/x/text": "v0.8.0",
+	},
+	"golang.org/x/net@v0.10.0": {
+		"golang.org/x/sys":  "v0.8.0",
+		"golang.org/x/term": "v0.8.0",
+		"golang.org/x/text": "v0.9.0",
+	},
+	"golang.org/x/net@v0.11.0": {
+		"golang.org/x/crypto": "v0.10.0",
+		"golang.org/x/sys":    "v0.9.0",
+		"golang.org/x/term":   "v0.9.0",
+		"golang.org/x/text":   "v0.10.0",
+	},
+	"golang.org/x/net@v0.12.0": {
+		"golang.org/x/crypto": "v0.11.0",
+		"golang.org/x/sys":    "v0.10.0",
+		"golang.org/x/term":   "v0.10.0",
+		"golang.org/x/text":   "v0.11.0",
+	},
+	"golang.org/x/net@v0.14.0": {
+		"golang.org/x/crypto": "v0.12.0",
+		"golang.org/x/sys":    "v0.11.0",
+		"golang.org/x/term":   "v0.11.0",
+		"golang.org/x/text":   "v0.12.0",
+	},
+	"golang.org/x/net@v0.15.0": {
+		"golang.org/x/crypto": "v0.13.0",
+		"golang.org/x/sys":    "v0.12.0",
+		"golang.org/x/term":   "v0.12.0",
+		"golang.org/x/text":   "v0.13.0",
+	},
+	"golang.org/x/net@v0.16.0": {
+		"golang.org/x/crypto": "v0.14.0",
+		"golang.org/x/sys":    "v0.13.0",
+		"golang.org/x/term":   "v0.13.0",
+		"golang.org/x/text":   "v0.13.0",
+	},
+	"golang.org/x/net@v0.17.0": {
+		"golang.org/x/crypto": "v0.14.0",
+		"golang.org/x/sys":    "v0.13.0",
+		"golang.org/x/term":   "v0.13.0",
+		"golang.org/x/text":   "v0.13.0",
+	},
+	"golang.org/x/net@v0.18.0": {
+		"golang.org/x/crypto": "v0.15.0",
+		"golang.org/x/sys":    "v0.14.0",
+		"golang.org/x/term":   "v0.14.0",
+		"golang.org/x/text":   "v0.14.0",
+	},
+	"golang.org/x/net@v0.19.0": {
+		"golang.org/x/crypto": "v0.16.0",
+		"golang.org/x/sys":    "v0.15.0",
+		"golang.org/x/term":   "v0.15.0",
+		"golang.org/x/text":   "v0.14.0",
+	},
+	"golang.org/x/net@v0.21.0": {
+		"golang.org/x/crypto": "v0.19.0",
+		"golang.org/x/sys":    "v0.17.0",
+		"golang.org/x/term":   "v0.17.0",
+		"golang.org/x/text":   "v0.14.0",
+	},
+	"golang.org/x/net@v0.22.0": {
+		"golang.org/x/crypto": "v0.21.0",
+		"golang.org/x/sys":    "v0.18.0",
+		"golang.org/x/term":   "v0.18.0",
+		"golang.org/x/text":   "v0.14.0",
+	},
+	"golang.org/x/oauth2@v0.0.0-20210402161424-2e8d93401602": {
+		"github.com/golang/groupcache": "v0.0.0-20200121045136-8c9f03a8e57e",
+	},
+	"golang.org/x/oauth2@v0.0.0-20210514164344-f6687ab2804c": {
+		"github.com/golang/groupcache": "v0.0.0-20200121045136-8c9f03a8e57e",
+	},
+	"golang.org/x/oauth2@v0.0.0-20220223155221-ee480838109b": {
+		"github.com/golang/groupcache": "v0.0.0-20200121045136-8c9f03a8e57e",
+	},
+	"golang.org/x/oauth2@v0.5.0": {
+		"github.com/google/go-cmp": "v0.5.8",
+	},
+	"golang.org/x/oauth2@v0.8.0": {
+		"github.com/google/go-cmp": "v0.5.8",
+		"golang.org/x/net":         "v0.10.0",
+	},
+	"golang.org/x/oauth2@v0.10.0": {
+		"github.com/golang/protobuf": "v1.5.3",
+		"github.com/google/go-cmp":   "v0.5.9",
+		"golang.org/x/net":           "v0.12.0",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"golang.org/x/oauth2@v0.11.0": {
+		"github.com/golang/protobuf": "v1.5.3",
+		"github.com/google/go-cmp":   "v0.5.9",
+		"golang.org/x/net":           "v0.14.0",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"golang.org/x/oauth2@v0.12.0": {
+		"github.com/golang/protobuf": "v1.5.3",
+		"github.com/google/go-cmp":   "v0.5.9",
+		"golang.org/x/net":           "v0.15.0",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"golang.org/x/oauth2@v0.13.0": {
+		"github.com/golang/protobuf": "v1.5.3",
+		"github.com/google/go-cmp":   "v0.5.9",
+		"golang.org/x/net":           "v0.16.0",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"golang.org/x/oauth2@v0.14.0": {
+		"github.com/golang/protobuf": "v1.5.3",
+		"github.com/google/go-cmp":   "v0.5.9",
+		"golang.org/x/net":           "v0.18.0",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"golang.org/x/oauth2@v0.15.0": {
+		"github.com/golang/protobuf": "v1.5.3",
+		"github.com/google/go-cmp":   "v0.5.9",
+		"golang.org/x/net":           "v0.19.0",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"golang.org/x/term@v0.6.0": {
+		"golang.org/x/sys": "v0.6.0",
+	},
+	"golang.org/x/term@v0.8.0": {
+		"golang.org/x/sys": "v0.8.0",
+	},
+	"golang.org/x/term@v0.9.0": {
+		"golang.org/x/sys": "v0.9.0",
+	},
+	"golang.org/x/term@v0.10.0": {
+		"golang.org/x/sys": "v0.10.0",
+	},
+	"golang.org/x/term@v0.11.0": {
+		"golang.org/x/sys": "v0.11.0",
+	},
+	"golang.org/x/term@v0.12.0": {
+		"golang.org/x/sys": "v0.12.0",
+	},
+	"golang.org/x/term@v0.13.0": {
+		"golang.org/x/sys": "v0.13.0",
+	},
+	"golang.org/x/term@v0.14.0": {
+		"golang.org/x/sys": "v0.14.0",
+	},
+	"golang.org/x/term@v0.15.0": {
+		"golang.org/x/sys": "v0.15.0",
+	},
+	"golang.org/x/term@v0.16.0": {
+		"golang.org/x/sys": "v0.16.0",
+	},
+	"golang.org/x/term@v0.17.0": {
+		"golang.org/x/sys": "v0.17.0",
+	},
+	"golang.org/x/term@v0.18.0": {
+		"golang.org/x/sys": "v0.18.0",
+	},
+	"golang.org/x/text@v0.3.8": {
+		"golang.org/x/sys": "v0.0.0-20220722155257-8c9f86f7a55f",
+	},
+	"golang.org/x/text@v0.4.0": {
+		"golang.org/x/sys": "v0.0.0-20220722155257-8c9f86f7a55f",
+	},
+	"golang.org/x/text@v0.7.0": {
+		"golang.org/x/sys": "v0.0.0-20220722155257-8c9f86f7a55f",
+	},
+	"golang.org/x/text@v0.8.0": {
+		"golang.org/x/sys":   "v0.5.0",
+		"golang.org/x/tools": "v0.6.0",
+	},
+	"golang.org/x/text@v0.9.0": {
+		"golang.org/x/sys":   "v0.5.0",
+		"golang.org/x/tools": "v0.6.0",
+	},
+	"golang.org/x/text@v0.10.0": {
+		"golang.org/x/sys":   "v0.5.0",
+		"golang.org/x/tools": "v0.6.0",
+	},
+	"golang.org/x/text@v0.11.0": {
+		"golang.org/x/sys":   "v0.5.0",
+		"golang.org/x/tools": "v0.6.0",
+	},
+	"golang.org/x/text@v0.12.0": {
+		"golang.org/x/sys":   "v0.5.0",
+		"golang.org/x/tools": "v0.6.0",
+	},
+	"golang.org/x/text@v0.13.0": {
+		"golang.org/x/sys":   "v0.5.0",
+		"golang.org/x/tools": "v0.6.0",
+	},
+	"golang.org/x/text@v0.14.0": {
+		"golang.org/x/sys":   "v0.5.0",
+		"golang.org/x/tools": "v0.6.0",
+	},
+	"golang.org/x/tools@v0.1.12": {
+		"golang.org/x/sys": "v0.0.0-20220722155257-8c9f86f7a55f",
+	},
+	"golang.org/x/tools@v0.2.0": {
+		"golang.org/x/sys": "v0.1.0",
+	},
+	"golang.org/x/tools@v0.6.0": {
+		"golang.org/x/sync": "v0.1.0",
+		"golang.org/x/sys":  "v0.5.0",
+	},
+	"golang.org/x/tools@v0.7.0": {
+		"golang.org/x/mod":  "v0.9.0",
+		"golang.org/x/net":  "v0.8.0",
+		"golang.org/x/sync": "v0.1.0",
+		"golang.org/x/sys":  "v0.6.0",
+	},
+	"golang.org/x/tools@v0.10.0": {
+		"golang.org/x/mod":  "v0.11.0",
+		"golang.org/x/net":  "v0.11.0",
+		"golang.org/x/sync": "v0.3.0",
+		"golang.org/x/sys":  "v0.9.0",
+	},
+	"golang.org/x/tools@v0.12.0": {
+		"golang.org/x/mod":  "v0.12.0",
+		"golang.org/x/net":  "v0.14.0",
+		"golang.org/x/sync": "v0.3.0",
+		"golang.org/x/sys":  "v0.11.0",
+	},
+	"golang.org/x/tools@v0.13.0": {
+		"golang.org/x/mod":  "v0.12.0",
+		"golang.org/x/net":  "v0.15.0",
+		"golang.org/x/sync": "v0.3.0",
+		"golang.org/x/sys":  "v0.12.0",
+	},
+	"golang.org/x/tools@v0.16.1": {
+		"golang.org/x/mod":  "v0.14.0",
+		"golang.org/x/net":  "v0.19.0",
+		"golang.org/x/sync": "v0.5.0",
+	},
+	"golang.org/x/tools@v0.19.0": {
+		"golang.org/x/mod":  "v0.16.0",
+		"golang.org/x/net":  "v0.22.0",
+		"golang.org/x/sync": "v0.6.0",
+		"golang.org/x/sys":  "v0.18.0",
+	},
+	"google.golang.org/appengine@v1.6.8": {
+		"github.com/google/go-cmp": "v0.5.5",
+		"golang.org/x/sys":         "v0.0.0-20220722155257-8c9f86f7a55f",
+	},
+	"google.golang.org/genproto/googleapis/api@v0.0.0-20231002182017-d307bd883b97": {
+		"github.com/golang/protobuf":                "v1.5.3",
+		"golang.org/x/net":                          "v0.10.0",
+		"golang.org/x/sys":                          "v0.8.0",
+		"golang.org/x/text":                         "v0.9.0",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20230920204549-e6e6cdab5c13",
+		"google.golang.org/protobuf":                "v1.31.0",
+	},
+	"google.golang.org/genproto/googleapis/api@v0.0.0-20231016165738-49dd2c1f3d0b": {
+		"github.com/golang/protobuf":                "v1.5.3",
+		"golang.org/x/net":                          "v0.17.0",
+		"golang.org/x/sys":                          "v0.13.0",
+		"golang.org/x/text":                         "v0.13.0",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20231012201019-e917dd12ba7a",
+		"google.golang.org/protobuf":                "v1.31.0",
+	},
+	"google.golang.org/genproto/googleapis/api@v0.0.0-20231106174013-bbf56f31fb17": {
+		"github.com/golang/protobuf":                "v1.5.3",
+		"golang.org/x/net":                          "v0.17.0",
+		"golang.org/x/sys":                          "v0.13.0",
+		"golang.org/x/text":                         "v0.13.0",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20231030173426-d783a09b4405",
+		"google.golang.org/grpc":                    "v1.59.0",
+		"google.golang.org/protobuf":                "v1.31.0",
+	},
+	"google.golang.org/genproto/googleapis/rpc@v0.0.0-20230711160842-782d3b101e98": {
+		"github.com/google/go-cmp":   "v0.5.9",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"google.golang.org/genproto/googleapis/rpc@v0.0.0-20230822172742-b8732ec3820d": {
+		"github.com/google/go-cmp":   "v0.5.9",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"google.golang.org/genproto/googleapis/rpc@v0.0.0-20230920204549-e6e6cdab5c13": {
+		"github.com/google/go-cmp":   "v0.5.9",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"google.golang.org/genproto/googleapis/rpc@v0.0.0-20231002182017-d307bd883b97": {
+		"github.com/google/go-cmp":   "v0.5.9",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"google.golang.org/genproto/googleapis/rpc@v0.0.0-20231012201019-e917dd12ba7a": {
+		"github.com/google/go-cmp":   "v0.5.9",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"google.golang.org/genproto/googleapis/rpc@v0.0.0-20231030173426-d783a09b4405": {
+		"github.com/google/go-cmp":   "v0.5.9",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"google.golang.org/genproto/googleapis/rpc@v0.0.0-20231120223509-83a465c0220f": {
+		"github.com/google/go-cmp":   "v0.5.9",
+		"google.golang.org/protobuf": "v1.31.0",
+	},
+	"google.golang.org/grpc@v1.58.3": {
+		"github.com/cespare/xxhash/v2": "v2.2.0",
+		"github.com/golang/glog":       "v1.1.0",
+		"github.com/golang/protobuf":   "v1.5.3",
+		"github.com/google/go-cmp":     "v0.5.9",
+		"github.com/google/uuid":       "v1.3.0",
+		"golang.org/x/net":             "v0.12.0",
+		"golang.org/x/oauth2":          "v0.10.0",
+		"golang.org/x/sync":            "v0.3.0",
+		"golang.org/x/sys":             "v0.10.0",
+		"golang.org/x/text":            "v0.11.0",
+		"google.golang.org/protobuf":   "v1.31.0",
+	},
+	"google.golang.org/grpc@v1.59.0": {
+		"github.com/cespare/xxhash/v2":              "v2.2.0",
+		"github.com/golang/glog":                    "v1.1.2",
+		"github.com/golang/protobuf":                "v1.5.3",
+		"github.com/google/go-cmp":                  "v0.5.9",
+		"github.com/google/uuid":                    "v1.3.1",
+		"golang.org/x/net":                          "v0.14.0",
+		"golang.org/x/oauth2":                       "v0.11.0",
+		"golang.org/x/sync":                         "v0.3.0",
+		"golang.org/x/sys":                          "v0.11.0",
+		"golang.org/x/text":                         "v0.12.0",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20230822172742-b8732ec3820d",
+		"google.golang.org/protobuf":                "v1.31.0",
+	},
+	"google.golang.org/grpc@v1.60.1": {
+		"github.com/cespare/xxhash/v2":              "v2.2.0",
+		"github.com/golang/glog":                    "v1.1.2",
+		"github.com/golang/protobuf":                "v1.5.3",
+		"github.com/google/go-cmp":                  "v0.5.9",
+		"github.com/google/uuid":                    "v1.3.1",
+		"golang.org/x/net":                          "v0.16.0",
+		"golang.org/x/oauth2":                       "v0.13.0",
+		"golang.org/x/sync":                         "v0.4.0",
+		"golang.org/x/sys":                          "v0.13.0",
+		"golang.org/x/text":                         "v0.13.0",
+		"google.golang.org/appengine":               "v1.6.8",
+		"google.golang.org/genproto/googleapis/rpc": "v0.0.0-20231002182017-d307bd883b97",
+		"google.golang.org/protobuf":                "v1.31.0",
+	},
+	"google.golang.org/protobuf@v1.28.1": {
+		"github.com/google/go-cmp": "v0.5.5",
+	},
+	"google.golang.org/protobuf@v1.29.1": {
+		"github.com/google/go-cmp": "v0.5.5",
+	},
+	"google.golang.org/protobuf@v1.30.0": {
+		"github.com/google/go-cmp": "v0.5.5",
+	},
+	"google.golang.org/protobuf@v1.31.0": {
+		"github.com/google/go-cmp": "v0.5.5",
+	},
+	"google.golang.org/protobuf@v1.32.0": {
+		"github.com/google/go-cmp": "v0.5.5",
+	},
+	"google.golang.org/protobuf@v1.33.0": {
+		"github.com/google/go-cmp": "v0.5.5",
+	},
+	"gotest.tools/v3@v3.5.0": {
+		"github.com/google/go-cmp": "v0.5.9",
+		"golang.org/x/sys":         "v0.1.0",
+		"golang.org/x/tools":       "v0.2.0",
+	},
+}
@@ -0,0 +1,691 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxOpenAPISize is the largest OpenAPI document ParseOpenAPI accepts
+const MaxOpenAPISize = 512 << 10
+
+const (
+	// maxAPIOperations bounds the handlers generated from one document
+	maxAPIOperations = 100
+
+	// maxAPISchemas bounds the structs generated from one document
+	maxAPISchemas = 200
+
+	// maxSchemaDepth bounds how deeply inline schemas may nest
+	maxSchemaDepth = 16
+)
+
+// reservedAPIPaths are routes the generated REST API already serves; a
+// document may not declare them again
+var reservedAPIPaths = []string{
+	"/health", "/healthz", "/readyz", "/metrics", "/ws", "/auth/login", "/auth/refresh",
+	"/api/v1/hello", "/openapi.yaml", "/swagger",
+}
+
+// reservedAPINames are identifiers of the generated openapi package that
+// operations and schemas may not take
+var reservedAPINames = map[string]bool{"Register": true}
+
+// pathParamPattern matches a path template parameter such as {id}
+var pathParamPattern = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// APISpec is the part of an OpenAPI document the generator scaffolds:
+// handlers for its GET and POST operations and structs for its JSON bodies
+type APISpec struct {
+	// Title is the document's info.title
+	Title string
+
+	// Operations in path order, GET before POST
+	Operations []APIOperation
+
+	// Schemas are the named components and the inline objects of bodies,
+	// sorted by name
+	Schemas []APISchema
+}
+
+// APIOperation is one operation of an OpenAPI document
+type APIOperation struct {
+	// Name is the Go name of the handler, from operationId or method and path
+	Name string
+
+	// Method is GET or POST
+	Method string
+
+	// Path is the path template of the document, such as /users/{id}
+	Path string
+
+	Summary string
+
+	// PathParams are the parameter names of Path, in order
+	PathParams []string
+
+	// QueryParams are the query parameters the operation declares
+	QueryParams []string
+
+	// Request is the Go type of the JSON request body; empty without a body
+	Request string
+
+	// Response is the Go type of the JSON success response; empty without one
+	Response string
+
+	// Status is the success status code
+	Status int
+}
+
+// ColonPath returns Path with parameters in the :id form of gin, echo and fiber
+func (o APIOperation) ColonPath() string {
+	return pathParamPattern.ReplaceAllString(o.Path, ":$1")
+}
+
+// MethodName returns Method as chi and fiber name their route methods: Get, Post
+func (o APIOperation) MethodName() string {
+	return o.Method[:1] + strings.ToLower(o.Method[1:])
+}
+
+// StatusCode returns Status as the net/http constant, such as http.StatusCreated
+func (o APIOperation) StatusCode() string {
+	return "http." + httpStatusNames[o.Status]
+}
+
+// httpStatusNames names the net/http constants of the success codes
+var httpStatusNames = map[int]string{
+	200: "StatusOK", 201: "StatusCreated", 202: "StatusAccepted", 203: "StatusNonAuthoritativeInfo",
+	204: "StatusNoContent", 205: "StatusResetContent", 206: "StatusPartialContent", 207: "StatusMultiStatus",
+	208: "StatusAlreadyReported", 226: "StatusIMUsed",
+}
+
+// APISchema is a Go type generated for a schema: a struct for an object,
+// otherwise a defined type such as "type Status string"
+type APISchema struct {
+	Name        string
+	Description string
+
+	// Type is the underlying type of a schema that isn't an object
+	Type string
+
+	Fields []APIField
+}
+
+// APIField is a field of an APISchema
+type APIField struct {
+	// Name is the Go field name
+	Name string
+
+	// JSONName is the property name in the document
+	JSONName string
+
+	// Type is the Go type
+	Type string
+
+	// Required properties are encoded even when they are the zero value
+	Required bool
+}
+
+// openAPIDocument is the part of an OpenAPI 3 document that is read
+type openAPIDocument struct {
+	OpenAPI string `json:"openapi"`
+	Swagger string `json:"swagger"`
+	Info    struct {
+		Title string `json:"title"`
+	} `json:"info"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]*openAPISchema `json:"schemas"`
+	} `json:"components"`
+}
+
+type openAPIOperation struct {
+	OperationID string                 `json:"operationId"`
+	Summary     string                 `json:"summary"`
+	Parameters  []openAPIParameter     `json:"parameters"`
+	RequestBody *openAPIBody           `json:"requestBody"`
+	Responses   map[string]openAPIBody `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Ref  string `json:"$ref"`
+	Name string `json:"name"`
+	In   string `json:"in"`
+}
+
+// openAPIBody is a request body or a response
+type openAPIBody struct {
+	Ref     string `json:"$ref"`
+	Content map[string]struct {
+		Schema *openAPISchema `json:"schema"`
+	} `json:"content"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref"`
+	Type                 any                       `json:"type"`
+	Format               string                    `json:"format"`
+	Description          string                    `json:"description"`
+	Properties           map[string]*openAPISchema `json:"properties"`
+	Required             []string                  `json:"required"`
+	Items                *openAPISchema            `json:"items"`
+	AdditionalProperties any                       `json:"additionalProperties"`
+	AllOf                []json.RawMessage         `json:"allOf"`
+	OneOf                []json.RawMessage         `json:"oneOf"`
+	AnyOf                []json.RawMessage         `json:"anyOf"`
+	Not                  json.RawMessage           `json:"not"`
+}
+
+// ParseOpenAPI reads an OpenAPI 3.0 or 3.1 document in JSON or YAML. Only
+// what the generated handlers can serve is accepted: GET and POST
+// operations, JSON bodies, local $refs to components/schemas and schemas
+// without composition. Anything else is rejected with an error naming it.
+func ParseOpenAPI(data []byte) (*APISpec, error) {
+	if len(data) > MaxOpenAPISize {
+		return nil, fmt.Errorf("document is %d bytes, the limit is %d", len(data), MaxOpenAPISize)
+	}
+	var doc openAPIDocument
+	if err := decodeOpenAPI(data, &doc); err != nil {
+		return nil, err
+	}
+	switch {
+	case doc.Swagger != "":
+		return nil, fmt.Errorf("swagger %s documents are not supported, convert them to OpenAPI 3", doc.Swagger)
+	case !strings.HasPrefix(doc.OpenAPI, "3.0.") && !strings.HasPrefix(doc.OpenAPI, "3.1."):
+		return nil, fmt.Errorf("openapi version %q is not supported, expected 3.0.x or 3.1.x", doc.OpenAPI)
+	case len(doc.Paths) == 0:
+		return nil, fmt.Errorf("document has no paths")
+	}
+
+	p := &apiParser{components: doc.Components.Schemas, owners: make(map[string]string)}
+	spec := &APISpec{Title: doc.Info.Title}
+
+	// Components first, so inline schemas can't take their names
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if err := p.claim(componentName(name), "schema "+name); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range names {
+		if err := p.addComponent(componentName(name), doc.Components.Schemas[name], "components.schemas."+name); err != nil {
+			return nil, err
+		}
+	}
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		params, err := checkAPIPath(path)
+		if err != nil {
+			return nil, err
+		}
+		item := doc.Paths[path]
+		for key := range item {
+			switch key {
+			case "get", "post", "summary", "description", "parameters", "servers":
+			case "$ref":
+				return nil, fmt.Errorf("path %s: path item references are not supported", path)
+			default:
+				return nil, fmt.Errorf("path %s: %s operations are not supported, only GET and POST", path, strings.ToUpper(key))
+			}
+		}
+		for _, method := range []string{"get", "post"} {
+			raw, ok := item[method]
+			if !ok {
+				continue
+			}
+			op, err := p.operation(strings.ToUpper(method), path, params, raw)
+			if err != nil {
+				return nil, err
+			}
+			spec.Operations = append(spec.Operations, op)
+		}
+	}
+	if len(spec.Operations) == 0 {
+		return nil, fmt.Errorf("document has no GET or POST operations")
+	}
+	if len(spec.Operations) > maxAPIOperations {
+		return nil, fmt.Errorf("document has %d operations, at most %d are supported", len(spec.Operations), maxAPIOperations)
+	}
+	if len(p.schemas) > maxAPISchemas {
+		return nil, fmt.Errorf("document needs %d structs, at most %d are supported", len(p.schemas), maxAPISchemas)
+	}
+
+	spec.Schemas = p.schemas
+	slices.SortFunc(spec.Schemas, func(a, b APISchema) int { return strings.Compare(a.Name, b.Name) })
+	return spec, nil
+}
+
+// decodeOpenAPI decodes a JSON or YAML document into doc. YAML is converted
+// to JSON first, so both are read through the same json tags.
+func decodeOpenAPI(data []byte, doc *openAPIDocument) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(trimmed, doc); err != nil {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+		return nil
+	}
+
+	var value any
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("invalid YAML: %w", err)
+	}
+	converted, err := json.Marshal(jsonValue(value))
+	if err != nil {
+		return fmt.Errorf("invalid YAML: %w", err)
+	}
+	if err := json.Unmarshal(converted, doc); err != nil {
+		return fmt.Errorf("unexpected document structure: %w", err)
+	}
+	return nil
+}
+
+// jsonValue converts decoded YAML into values encoding/json can marshal:
+// mapping keys such as the 200 of a response become strings
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = jsonValue(e)
+		}
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = jsonValue(e)
+		}
+		return m
+	case []any:
+		for i, e := range v {
+			v[i] = jsonValue(e)
+		}
+		return v
+	}
+	return v
+}
+
+// checkAPIPath checks a path template and returns its parameter names. Each
+// parameter must take a whole segment, which every router can match.
+func checkAPIPath(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path %s must start with a slash", path)
+	}
+	for _, reserved := range reservedAPIPaths {
+		if path == reserved || strings.HasPrefix(path, reserved+"/") {
+			return nil, fmt.Errorf("path %s is already served by the generated project", path)
+		}
+	}
+	var params []string
+	for _, segment := range strings.Split(path[1:], "/") {
+		m := pathParamPattern.FindStringSubmatch(segment)
+		if m == nil {
+			if strings.ContainsAny(segment, "{}:*") {
+				return nil, fmt.Errorf("path %s: segment %q is not supported", path, segment)
+			}
+			continue
+		}
+		if m[0] != segment {
+			return nil, fmt.Errorf("path %s: parameter {%s} must take a whole path segment", path, m[1])
+		}
+		if !token.IsIdentifier(m[1]) {
+			return nil, fmt.Errorf("path %s: parameter name %q must be a letter followed by letters, digits or underscores", path, m[1])
+		}
+		if slices.Contains(params, m[1]) {
+			return nil, fmt.Errorf("path %s: parameter {%s} appears twice", path, m[1])
+		}
+		params = append(params, m[1])
+	}
+	return params, nil
+}
+
+// apiParser collects the structs of a document while its operations are read
+type apiParser struct {
+	components map[string]*openAPISchema
+	schemas    []APISchema
+
+	// owners maps every Go identifier handed out to what it was generated for
+	owners map[string]string
+}
+
+// claim reserves the Go identifier name for owner, describing the clash
+// when it is taken
+func (p *apiParser) claim(name, owner string) error {
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		return fmt.Errorf("%s: %q is not a usable Go name", owner, name)
+	}
+	if reservedAPINames[name] {
+		return fmt.Errorf("%s: the name %s is used by the generated code", owner, name)
+	}
+	if first, ok := p.owners[name]; ok {
+		return fmt.Errorf("%s and %s both generate %s", first, owner, name)
+	}
+	p.owners[name] = owner
+	return nil
+}
+
+// componentName returns the Go type name of a schema in components
+func componentName(name string) string {
+	return toPascalCase(name)
+}
+
+// operation reads the operation at method and path
+func (p *apiParser) operation(method, path string, pathParams []string, raw json.RawMessage) (APIOperation, error) {
+	where := method + " " + path
+	var o openAPIOperation
+	if err := json.Unmarshal(raw, &o); err != nil {
+		return APIOperation{}, fmt.Errorf("%s: %w", where, err)
+	}
+
+	op := APIOperation{Method: method, Path: path, Summary: strings.Join(strings.Fields(o.Summary), " "), PathParams: pathParams}
+	op.Name = toPascalCase(o.OperationID)
+	if o.OperationID == "" {
+		op.Name = operationName(method, path)
+	}
+	if err := p.claim(op.Name, "operation "+where); err != nil {
+		return APIOperation{}, err
+	}
+
+	for _, param := range o.Parameters {
+		switch {
+		case param.Ref != "":
+			return APIOperation{}, fmt.Errorf("%s: parameter references are not supported", where)
+		case param.In == "query":
+			op.QueryParams = append(op.QueryParams, param.Name)
+		case param.In == "path" && !slices.Contains(pathParams, param.Name):
+			return APIOperation{}, fmt.Errorf("%s: path parameter %s is not in the path", where, param.Name)
+		}
+	}
+
+	if o.RequestBody != nil {
+		if method == http.MethodGet {
+			return APIOperation{}, fmt.Errorf("%s: GET operations can't have a request body", where)
+		}
+		typ, err := p.bodyType(o.RequestBody, op.Name+"Request", where+" request body")
+		if err != nil {
+			return APIOperation{}, err
+		}
+		op.Request = typ
+	}
+
+	// The lowest 2xx response is the one the stub returns
+	op.Status = http.StatusOK
+	codes := make([]string, 0, len(o.Responses))
+	for code := range o.Responses {
+		if len(code) == 3 && code[0] == '2' {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) > 0 {
+		slices.Sort(codes)
+		status, err := strconv.Atoi(codes[0])
+		if _, ok := httpStatusNames[status]; err != nil || !ok {
+			return APIOperation{}, fmt.Errorf("%s: response code %s is not supported", where, codes[0])
+		}
+		op.Status = status
+		body := o.Responses[codes[0]]
+		typ, err := p.bodyType(&body, op.Name+"Response", where+" response "+codes[0])
+		if err != nil {
+			return APIOperation{}, err
+		}
+		op.Response = typ
+	}
+	if op.Response != "" && op.Status == http.StatusNoContent {
+		return APIOperation{}, fmt.Errorf("%s: a 204 response can't have a body", where)
+	}
+	return op, nil
+}
+
+// operationName names an operation without an operationId by its method and
+// path: GET /users/{id} becomes GetUsersByID
+func operationName(method, path string) string {
+	var b strings.Builder
+	b.WriteString(capitalize(method))
+	for _, segment := range strings.Split(path, "/") {
+		if m := pathParamPattern.FindStringSubmatch(segment); m != nil {
+			b.WriteString("By" + toPascalCase(m[1]))
+			continue
+		}
+		b.WriteString(toPascalCase(segment))
+	}
+	return b.String()
+}
+
+// bodyType returns the Go type of a JSON request body or response, or ""
+// when it has no content. An inline object becomes a struct named name.
+func (p *apiParser) bodyType(body *openAPIBody, name, where string) (string, error) {
+	if body.Ref != "" {
+		return "", fmt.Errorf("%s: references to shared request bodies and responses are not supported", where)
+	}
+	if len(body.Content) == 0 {
+		return "", nil
+	}
+	media, ok := body.Content["application/json"]
+	if !ok {
+		types := make([]string, 0, len(body.Content))
+		for t := range body.Content {
+			types = append(types, t)
+		}
+		slices.Sort(types)
+		return "", fmt.Errorf("%s: content type %s is not supported, only application/json", where, strings.Join(types, ", "))
+	}
+	if media.Schema == nil {
+		return "any", nil
+	}
+	return p.goType(media.Schema, name, where, 0)
+}
+
+// goType returns the Go type of schema. Inline objects become structs named
+// name; where locates the schema in error messages.
+func (p *apiParser) goType(schema *openAPISchema, name, where string, depth int) (string, error) {
+	if depth > maxSchemaDepth {
+		return "", fmt.Errorf("%s: schemas nest more than %d levels deep", where, maxSchemaDepth)
+	}
+	if schema == nil {
+		return "any", nil
+	}
+	if err := checkComposition(schema, where); err != nil {
+		return "", err
+	}
+
+	if schema.Ref != "" {
+		ref, ok := strings.CutPrefix(schema.Ref, "#/components/schemas/")
+		if !ok {
+			return "", fmt.Errorf("%s: reference %s is not supported, only #/components/schemas/ references are", where, schema.Ref)
+		}
+		if _, ok := p.components[ref]; !ok {
+			return "", fmt.Errorf("%s: reference %s names no schema", where, schema.Ref)
+		}
+		return componentName(ref), nil
+	}
+
+	typ, err := schemaType(schema, where)
+	if err != nil {
+		return "", err
+	}
+	switch typ {
+	case "string":
+		return "string", nil
+	case "boolean":
+		return "bool", nil
+	case "integer":
+		switch schema.Format {
+		case "int32":
+			return "int32", nil
+		case "int64":
+			return "int64", nil
+		}
+		return "int", nil
+	case "number":
+		if schema.Format == "float" {
+			return "float32", nil
+		}
+		return "float64", nil
+	case "array":
+		if schema.Items == nil {
+			return "[]any", nil
+		}
+		elem, err := p.goType(schema.Items, name+"Item", where+" items", depth+1)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object":
+		if len(schema.Properties) > 0 {
+			if err := p.claim(name, where); err != nil {
+				return "", err
+			}
+			if err := p.addStruct(name, schema, where, depth); err != nil {
+				return "", err
+			}
+			return name, nil
+		}
+		if values, ok := schema.AdditionalProperties.(map[string]any); ok {
+			var elem openAPISchema
+			raw, _ := json.Marshal(values)
+			if err := json.Unmarshal(raw, &elem); err != nil {
+				return "", fmt.Errorf("%s: %w", where, err)
+			}
+			elemType, err := p.goType(&elem, name+"Value", where+" additionalProperties", depth+1)
+			if err != nil {
+				return "", err
+			}
+			return "map[string]" + elemType, nil
+		}
+		return "map[string]any", nil
+	}
+	return "any", nil
+}
+
+// schemaDescription returns the description of schema on one line, or
+// where it was generated from when it has none
+func schemaDescription(schema *openAPISchema, where string) string {
+	if schema.Description == "" {
+		return "is generated from " + where
+	}
+	return strings.Join(strings.Fields(schema.Description), " ")
+}
+
+// checkComposition rejects the schema composition keywords, which have no
+// plain Go struct equivalent
+func checkComposition(schema *openAPISchema, where string) error {
+	switch {
+	case len(schema.AllOf) > 0:
+		return fmt.Errorf("%s: allOf is not supported", where)
+	case len(schema.OneOf) > 0:
+		return fmt.Errorf("%s: oneOf is not supported", where)
+	case len(schema.AnyOf) > 0:
+		return fmt.Errorf("%s: anyOf is not supported", where)
+	case schema.Not != nil:
+		return fmt.Errorf("%s: not is not supported", where)
+	}
+	return nil
+}
+
+// schemaType returns the single type of schema: "object" for a schema with
+// properties and no type, "" for a schema without either. OpenAPI 3.1 type
+// lists may only add "null".
+func schemaType(schema *openAPISchema, where string) (string, error) {
+	switch t := schema.Type.(type) {
+	case nil:
+		if len(schema.Properties) > 0 || schema.AdditionalProperties != nil {
+			return "object", nil
+		}
+		return "", nil
+	case string:
+		return t, nil
+	case []any:
+		var types []string
+		for _, e := range t {
+			if s, ok := e.(string); ok && s != "null" {
+				types = append(types, s)
+			}
+		}
+		if len(types) == 1 {
+			return types[0], nil
+		}
+	}
+	return "", fmt.Errorf("%s: type %v is not supported, expected a single type", where, schema.Type)
+}
+
+// addComponent adds the type name for a schema of components, whose name
+// has been claimed already
+func (p *apiParser) addComponent(name string, schema *openAPISchema, where string) error {
+	if schema == nil {
+		schema = &openAPISchema{}
+	}
+	if err := checkComposition(schema, where); err != nil {
+		return err
+	}
+	typ, err := schemaType(schema, where)
+	if err != nil {
+		return err
+	}
+	if typ == "object" && len(schema.Properties) > 0 {
+		return p.addStruct(name, schema, where, 0)
+	}
+
+	// Anything else is a defined type over the Go type of the schema; the
+	// name is its own, so inline objects below it are named after it
+	underlying, err := p.goType(schema, name+"Value", where, 1)
+	if err != nil {
+		return err
+	}
+	p.schemas = append(p.schemas, APISchema{Name: name, Description: schemaDescription(schema, where), Type: underlying})
+	return nil
+}
+
+// addStruct adds the struct name for an object schema with properties,
+// whose name has been claimed already
+func (p *apiParser) addStruct(name string, schema *openAPISchema, where string, depth int) error {
+
+	props := make([]string, 0, len(schema.Properties))
+	for prop := range schema.Properties {
+		props = append(props, prop)
+	}
+	slices.Sort(props)
+
+	s := APISchema{Name: name, Description: schemaDescription(schema, where)}
+	fieldOwners := make(map[string]string, len(props))
+	for _, prop := range props {
+		if prop == "" || strings.ContainsAny(prop, "\"`\\") || strings.ContainsFunc(prop, unicode.IsControl) {
+			return fmt.Errorf("%s: property name %q is not supported", where, prop)
+		}
+		field := toPascalCase(prop)
+		if field == "" || !token.IsIdentifier(field) {
+			field = "Field" + field
+		}
+		if !token.IsIdentifier(field) {
+			return fmt.Errorf("%s: property %q has no usable Go name", where, prop)
+		}
+		if first, ok := fieldOwners[field]; ok {
+			return fmt.Errorf("%s: properties %q and %q both generate the field %s", where, first, prop, field)
+		}
+		fieldOwners[field] = prop
+
+		typ, err := p.goType(schema.Properties[prop], name+field, where+"."+prop, depth+1)
+		if err != nil {
+			return err
+		}
+		s.Fields = append(s.Fields, APIField{Name: field, JSONName: prop, Type: typ, Required: slices.Contains(schema.Required, prop)})
+	}
+	p.schemas = append(p.schemas, s)
+	return nil
+}
@@ -0,0 +1,173 @@
+package generator
+
+import (
+	"fmt"
+	"maps"
+)
+
+// Dependency describes an optional dependency that can be added to a project
+type Dependency struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Package  string `json:"package"`
+	Version  string `json:"version"`
+
+	// Stdlib marks standard library packages, which are selectable but add
+	// nothing to go.mod
+	Stdlib bool `json:"stdlib,omitempty"`
+}
+
+// Options lists every valid choice accepted by the generator
+type Options struct {
+	Structures   []string     `json:"structures"`
+	ProjectTypes []string     `json:"project_types"`
+	Routers      []string     `json:"routers"`
+	Loggers      []string     `json:"loggers"`
+	Dependencies []Dependency `json:"dependencies"`
+}
+
+// dependencyCatalog is the full list of selectable dependencies and their default versions
+var dependencyCatalog = []Dependency{
+	{Name: "Chi Router", Category: "WEB", Package: "github.com/go-chi/chi/v5", Version: "v5.0.11"},
+	{Name: "Gin Web Framework", Category: "WEB", Package: "github.com/gin-gonic/gin", Version: "v1.9.1"},
+	{Name: "Echo", Category: "WEB", Package: "github.com/labstack/echo/v4", Version: "v4.11.4"},
+	{Name: "Fiber", Category: "WEB", Package: "github.com/gofiber/fiber/v2", Version: "v2.52.0"},
+	{Name: "Gorilla Mux", Category: "WEB", Package: "github.com/gorilla/mux", Version: "v1.8.1"},
+	{Name: "Cobra", Category: "CLI", Package: "github.com/spf13/cobra", Version: "v1.8.0"},
+	{Name: "urfave/cli", Category: "CLI", Package: "github.com/urfave/cli/v2", Version: "v2.27.1"},
+	{Name: "Bubble Tea", Category: "CLI", Package: "github.com/charmbracelet/bubbletea", Version: "v0.25.0"},
+	{Name: "Kong", Category: "CLI", Package: "github.com/alecthomas/kong", Version: "v0.8.1"},
+	{Name: "Templ", Category: "TEMPLATE", Package: "github.com/a-h/templ", Version: "v0.2.543"},
+	{Name: "Pongo2", Category: "TEMPLATE", Package: "github.com/flosch/pongo2/v6", Version: "v6.0.0"},
+	{Name: "html/template", Category: "TEMPLATE", Package: "html/template", Stdlib: true},
+	{Name: "PostgreSQL Driver (pgx)", Category: "DATABASE", Package: "github.com/jackc/pgx/v5", Version: "v5.5.1"},
+	{Name: "MySQL Driver", Category: "DATABASE", Package: "github.com/go-sql-driver/mysql", Version: "v1.7.1"},
+	{Name: "GORM", Category: "DATABASE", Package: "gorm.io/gorm", Version: "v1.25.5"},
+	{Name: "sqlx", Category: "DATABASE", Package: "github.com/jmoiron/sqlx", Version: "v1.3.5"},
+	{Name: "SQLite Driver", Category: "DATABASE", Package: "github.com/mattn/go-sqlite3", Version: "v1.14.19"},
+	{Name: "Redis Client (go-redis)", Category: "NOSQL", Package: "github.com/redis/go-redis/v9", Version: "v9.4.0"},
+	{Name: "MongoDB Driver", Category: "NOSQL", Package: "go.mongodb.org/mongo-driver", Version: "v1.13.1"},
+	{Name: "BadgerDB", Category: "NOSQL", Package: "github.com/dgraph-io/badger/v4", Version: "v4.2.0"},
+	{Name: "Zerolog", Category: "LOGGING", Package: "github.com/rs/zerolog", Version: "v1.32.0"},
+	{Name: "Zap", Category: "LOGGING", Package: "go.uber.org/zap", Version: "v1.26.0"},
+	{Name: "Logrus", Category: "LOGGING", Package: "github.com/sirupsen/logrus", Version: "v1.9.3"},
+	{Name: "slog", Category: "LOGGING", Package: "log/slog", Stdlib: true},
+	{Name: "Prometheus Client", Category: "OBSERVABILITY", Package: "github.com/prometheus/client_golang", Version: "v1.18.0"},
+	{Name: "OpenTelemetry", Category: "OBSERVABILITY", Package: "go.opentelemetry.io/otel", Version: "v1.22.0"},
+	{Name: "RabbitMQ Client", Category: "MESSAGING", Package: "github.com/rabbitmq/amqp091-go", Version: "v1.9.0"},
+	{Name: "AWS SQS SDK", Category: "MESSAGING", Package: "github.com/aws/aws-sdk-go-v2/service/sqs", Version: "v1.29.7"},
+	{Name: "Kafka Client (Sarama)", Category: "STREAMS", Package: "github.com/IBM/sarama", Version: "v1.42.2"},
+	{Name: "NATS", Category: "STREAMS", Package: "github.com/nats-io/nats.go", Version: "v1.31.0"},
+	{Name: "Pulsar Client", Category: "STREAMS", Package: "github.com/apache/pulsar-client-go", Version: "v0.12.0"},
+	{Name: "Confluent Kafka Go", Category: "STREAMS", Package: "github.com/confluentinc/confluent-kafka-go/v2", Version: "v2.3.0"},
+	{Name: "Segmentio Kafka-Go", Category: "STREAMS", Package: "github.com/segmentio/kafka-go", Version: "v0.4.47"},
+	{Name: "Twmb Franz-Go", Category: "STREAMS", Package: "github.com/twmb/franz-go", Version: "v1.15.4"},
+	{Name: "Gorilla WebSocket", Category: "WEBSOCKET", Package: "github.com/gorilla/websocket", Version: "v1.5.1"},
+	{Name: "nhooyr.io/websocket", Category: "WEBSOCKET", Package: "nhooyr.io/websocket", Version: "v1.8.10"},
+	{Name: "JWT-Go", Category: "SECURITY", Package: "github.com/golang-jwt/jwt/v5", Version: "v5.2.0"},
+	{Name: "Bcrypt", Category: "SECURITY", Package: "golang.org/x/crypto", Version: "v0.18.0"},
+	{Name: "Casbin", Category: "SECURITY", Package: "github.com/casbin/casbin/v2", Version: "v2.82.0"},
+	{Name: "Testify", Category: "TESTING", Package: "github.com/stretchr/testify", Version: "v1.8.4"},
+	{Name: "GoMock", Category: "TESTING", Package: "go.uber.org/mock", Version: "v0.4.0"},
+	{Name: "Ginkgo", Category: "TESTING", Package: "github.com/onsi/ginkgo/v2", Version: "v2.15.0"},
+}
+
+// GetOptions returns the structures, project types, routers, loggers and
+// dependency catalog supported by the generator
+func GetOptions() Options {
+	deps := make([]Dependency, len(dependencyCatalog))
+	copy(deps, dependencyCatalog)
+
+	return Options{
+		Structures:   []string{"standard", "flat", "feature", "hexagonal", "clean"},
+		ProjectTypes: []string{"rest-api", "cli", "grpc", "graphql", "library"},
+		Routers:      []string{"chi", "gin", "echo", "fiber", "stdlib"},
+		Loggers:      []string{"zerolog", "zap", "slog", "logrus", "stdlib"},
+		Dependencies: deps,
+	}
+}
+
+// ValidateCatalog checks that every catalog entry has a name and category, a
+// module path and a semantic version (standard library entries have no
+// version), and that no name or package appears twice
+func ValidateCatalog() error {
+	names := make(map[string]bool)
+	packages := make(map[string]bool)
+	for _, d := range dependencyCatalog {
+		if d.Name == "" || d.Category == "" {
+			return fmt.Errorf("catalog entry for %q is missing its name or category", d.Package)
+		}
+		if names[d.Name] {
+			return fmt.Errorf("catalog name %q is listed twice", d.Name)
+		}
+		if packages[d.Package] {
+			return fmt.Errorf("catalog package %q is listed twice", d.Package)
+		}
+		names[d.Name], packages[d.Package] = true, true
+
+		if d.Stdlib {
+			if d.Package == "" || d.Version != "" {
+				return fmt.Errorf("standard library entry %s needs a package and no version", d.Name)
+			}
+			continue
+		}
+		if err := ValidateModulePath(d.Package); err != nil {
+			return fmt.Errorf("catalog entry %s: %w", d.Name, err)
+		}
+		if err := ValidateVersion(d.Version); err != nil {
+			return fmt.Errorf("catalog entry %s: %w", d.Name, err)
+		}
+	}
+	return nil
+}
+
+// lookupDependency finds a catalog entry by its display name or package path
+func lookupDependency(dep string) (Dependency, bool) {
+	for _, d := range dependencyCatalog {
+		if d.Name == dep || d.Package == dep {
+			return d, true
+		}
+	}
+	return Dependency{}, false
+}
+
+// dedupeDependencies drops the entries of config.Dependencies that resolve to
+// the same package as an earlier one, e.g. "github.com/go-chi/chi/v5" after
+// "Chi Router", and returns the dropped entries. A version pinned on a dropped
+// entry carries over to the kept one unless that has its own. Entries missing
+// from the catalog are compared as given.
+func dedupeDependencies(config ProjectConfig) (ProjectConfig, []string) {
+	kept := make(map[string]string, len(config.Dependencies))
+	unique := make([]string, 0, len(config.Dependencies))
+	var duplicates []string
+	var versions map[string]string
+	for _, dep := range config.Dependencies {
+		key := dep
+		if d, ok := lookupDependency(dep); ok {
+			key = d.Package
+		}
+		first, seen := kept[key]
+		if !seen {
+			kept[key] = dep
+			unique = append(unique, dep)
+			continue
+		}
+		duplicates = append(duplicates, dep)
+
+		if version := config.DependencyVersions[dep]; version != "" && config.DependencyVersions[first] == "" {
+			if versions == nil {
+				versions = maps.Clone(config.DependencyVersions)
+			}
+			versions[first] = version
+		}
+	}
+	if len(duplicates) == 0 {
+		return config, nil
+	}
+
+	config.Dependencies = unique
+	if versions != nil {
+		config.DependencyVersions = versions
+	}
+	return config, duplicates
+}
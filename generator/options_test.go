@@ -0,0 +1,39 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateCatalog(t *testing.T) {
+	if err := ValidateCatalog(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateCatalogRejects(t *testing.T) {
+	valid := Dependency{Name: "Example", Category: "UTILITIES", Package: "example.com/lib", Version: "v1.0.0"}
+	tests := []struct {
+		name    string
+		catalog []Dependency
+		want    string
+	}{
+		{"missing name", []Dependency{{Category: "UTILITIES", Package: "example.com/lib", Version: "v1.0.0"}}, "missing its name"},
+		{"missing category", []Dependency{{Name: "Example", Package: "example.com/lib", Version: "v1.0.0"}}, "missing its name or category"},
+		{"duplicate name", []Dependency{valid, {Name: "Example", Category: "UTILITIES", Package: "example.com/other", Version: "v1.0.0"}}, "listed twice"},
+		{"duplicate package", []Dependency{valid, {Name: "Other", Category: "UTILITIES", Package: "example.com/lib", Version: "v1.0.0"}}, "listed twice"},
+		{"stdlib with version", []Dependency{{Name: "Slog", Category: "LOGGING", Package: "log/slog", Version: "v1.0.0", Stdlib: true}}, "no version"},
+		{"invalid module path", []Dependency{{Name: "Example", Category: "UTILITIES", Package: "Example Lib", Version: "v1.0.0"}}, "catalog entry Example"},
+		{"invalid version", []Dependency{{Name: "Example", Category: "UTILITIES", Package: "example.com/lib", Version: "1.0"}}, "catalog entry Example"},
+	}
+
+	catalog := dependencyCatalog
+	t.Cleanup(func() { dependencyCatalog = catalog })
+	for _, tt := range tests {
+		dependencyCatalog = tt.catalog
+		err := ValidateCatalog()
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: ValidateCatalog() = %v, want an error containing %q", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// minGoMinor is the oldest Go 1.x release the templates build with; the
+// stdlib router relies on the method patterns added to net/http in Go 1.22
+const minGoMinor = 22
+
+// goVersionPattern matches a Go release version such as 1.22 or 1.22.3
+var goVersionPattern = regexp.MustCompile(`^1\.(0|[1-9]\d*)(\.(0|[1-9]\d*))?$`)
+
+// NormalizeGoVersion validates a Go release version ("1.22", "1.22.3" or
+// "go1.22.3") and returns it in full release form, e.g. "1.22.0" or "1.22.3".
+// Since Go 1.21 a bare "1.22" in go.mod names the language version rather
+// than a release, so the patch number is always spelled out.
+func NormalizeGoVersion(v string) (string, error) {
+	minor, patch, err := parseGoVersion(v)
+	if err != nil {
+		return "", err
+	}
+	if minor < minGoMinor {
+		return "", fmt.Errorf("Go version %q is too old, the generated code needs Go 1.%d or newer", v, minGoMinor)
+	}
+	return fmt.Sprintf("1.%d.%d", minor, patch), nil
+}
+
+// parseGoVersion splits a Go release version into its minor and patch numbers
+func parseGoVersion(v string) (minor, patch int, err error) {
+	m := goVersionPattern.FindStringSubmatch(strings.TrimPrefix(strings.TrimSpace(v), "go"))
+	if m == nil {
+		return 0, 0, fmt.Errorf("Go version %q is not a release version such as 1.22 or 1.22.3", v)
+	}
+	minor, err = strconv.Atoi(m[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Go version %q: %w", v, err)
+	}
+	if m[3] != "" {
+		patch, err = strconv.Atoi(m[3])
+		if err != nil {
+			return 0, 0, fmt.Errorf("Go version %q: %w", v, err)
+		}
+	}
+	return minor, patch, nil
+}
+
+// DefaultPort is the HTTP port generated services listen on unless
+// ProjectConfig.Port says otherwise
+const DefaultPort = 8080
+
+// ValidateStructure checks that structure is one of the layouts listed by
+// GetOptions. Empty is accepted and means "standard".
+func ValidateStructure(structure string) error {
+	structures := GetOptions().Structures
+	if structure == "" || slices.Contains(structures, structure) {
+		return nil
+	}
+	return fmt.Errorf("unknown structure %q, expected one of %s", structure, strings.Join(structures, ", "))
+}
+
+// ValidateProjectType checks that projectType can be generated in the
+// structure layout. A library is a single package at the module root, which
+// only the standard layout generates; the others are built around a main
+// package. gRPC servers and protos only exist in the standard and feature
+// layouts.
+func ValidateProjectType(projectType, structure string) error {
+	if projectType == "library" && structure != "" && structure != "standard" {
+		return fmt.Errorf("library projects are only available with the standard structure, not %q", structure)
+	}
+	if projectType == "grpc" && structure != "" && structure != "standard" && structure != "feature" {
+		return fmt.Errorf("gRPC projects are only available with the standard and feature structures, not %q", structure)
+	}
+	return nil
+}
+
+// DefaultBranchName is the branch generated CI pipelines run on unless
+// ProjectConfig.DefaultBranch says otherwise
+const DefaultBranchName = "main"
+
+// maxBranchLength bounds DefaultBranch, which ends up in YAML and badge URLs
+const maxBranchLength = 100
+
+// branchPattern matches a git branch name made of letters, digits and
+// . _ - /, starting and ending with a letter or digit. That is stricter than
+// git, but keeps the name safe to write unquoted into CI YAML.
+var branchPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+
+// ValidateBranchName checks that name is a usable default branch, such as
+// main, master, develop or release/1.x
+func ValidateBranchName(name string) error {
+	if len(name) > maxBranchLength {
+		return fmt.Errorf("branch name %q is longer than %d characters", name, maxBranchLength)
+	}
+	if !branchPattern.MatchString(name) {
+		return fmt.Errorf("branch name %q must use letters, digits and . _ - / only, and start and end with a letter or digit", name)
+	}
+	if strings.Contains(name, "..") || strings.Contains(name, "//") || strings.Contains(name, "/.") || strings.HasSuffix(name, ".lock") {
+		return fmt.Errorf("branch name %q is not a valid git branch name", name)
+	}
+	return nil
+}
+
+// ValidatePort checks that port is a usable TCP port number
+func ValidatePort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("port %d is out of range, expected 1-65535", port)
+	}
+	return nil
+}
+
+// DefaultFeatureName is the sample feature generated by the feature layout
+// unless ProjectConfig.FeatureName says otherwise
+const DefaultFeatureName = "user"
+
+// featureNamePattern matches a lower case Go identifier, which keeps the
+// feature's package name, directory and routes conventional
+var featureNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// reservedFeatureNames are identifiers the generated feature package or main
+// package already use: imported package names, local variables, helper
+// functions and the package's other types
+var reservedFeatureNames = map[string]bool{
+	"api": true, "app": true, "bson": true, "c": true, "cache": true, "cancel": true,
+	"cfg": true, "chi": true, "codes": true, "collectionName": true, "config": true,
+	"context": true, "created": true, "ctx": true, "cursor": true, "database": true, "db": true,
+	"e": true, "echo": true, "echomiddleware": true, "err": true, "errors": true, "g": true,
+	"gin": true, "grpc": true, "h": true, "health": true, "http": true, "id": true, "json": true,
+	"lis": true, "log": true, "logger": true, "metrics": true, "middleware": true, "mongo": true,
+	"msg": true, "mux": true, "net": true, "now": true, "options": true, "opts": true, "os": true,
+	"port": true, "primitive": true, "probes": true, "quit": true, "r": true, "rdb": true,
+	"reflection": true, "repo": true, "req": true, "resp": true, "result": true, "s": true,
+	"service": true, "shutdownTimeout": true, "shutdownTracing": true, "signal": true,
+	"srv": true, "status": true, "statusFor": true, "syscall": true, "time": true,
+	"timestamppb": true, "toProto": true, "toStatus": true, "tracing": true, "update": true,
+	"updated": true, "w": true, "writeError": true, "writeJSON": true,
+
+	"ErrNotFound": true, "GRPCServer": true, "Handler": true, "Repository": true, "Service": true,
+	"TestServiceGet": true, "TestServiceUpdate": true,
+}
+
+// ValidateFeatureName checks that a feature name is a lower case Go
+// identifier and that neither it nor the type and variable names derived from
+// it collide with a keyword, a predeclared identifier or a name the generated
+// code already uses
+func ValidateFeatureName(name string) error {
+	if !featureNamePattern.MatchString(name) {
+		return fmt.Errorf("feature name %q must be a lower case Go identifier such as %q", name, "order")
+	}
+	for _, ident := range []string{name, toLowerCamel(name), toLowerCamel(plural(name)), toPascalCase(name)} {
+		if goKeywords[ident] || types.Universe.Lookup(ident) != nil {
+			return fmt.Errorf("feature name %q is a reserved Go identifier", name)
+		}
+		if reservedFeatureNames[ident] {
+			return fmt.Errorf("feature name %q clashes with %s in the generated code", name, ident)
+		}
+	}
+	return nil
+}
+
+// ValidateFeatures checks every name with ValidateFeatureName and that no two
+// features generate the same package, route or identifier in main. "user"
+// and "users" clash, for instance: the route group variable of the first is
+// named like the package of the second.
+func ValidateFeatures(names []string) error {
+	owners := make(map[string]string)
+	for _, name := range names {
+		if err := ValidateFeatureName(name); err != nil {
+			return err
+		}
+		camel := toLowerCamel(name)
+		for _, ident := range []string{name, camel + "Service", camel + "Handler", toLowerCamel(plural(name)), "/api/v1/" + plural(name)} {
+			owner, taken := owners[ident]
+			if taken && owner == name {
+				return fmt.Errorf("feature %q is listed twice", name)
+			}
+			if taken {
+				return fmt.Errorf("features %q and %q both generate %s", owner, name, ident)
+			}
+			owners[ident] = name
+		}
+	}
+	return nil
+}
+
+// servicePattern matches a service name: lower case letters, digits and
+// hyphens, so it works as a directory, binary, Makefile word and image name
+var servicePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// reservedServiceNames are directory names the go command treats specially,
+// which would change what cmd/<service> means
+var reservedServiceNames = map[string]bool{"internal": true, "testdata": true, "vendor": true}
+
+// maxServices bounds the services of one monorepo
+const maxServices = 16
+
+// ValidateServices checks the services of a monorepo: each name must be a
+// valid directory name of the form "api" or "order-worker", and no two
+// services may share a cmd/ directory
+func ValidateServices(names []string) error {
+	if len(names) > maxServices {
+		return fmt.Errorf("%d services listed, at most %d are supported", len(names), maxServices)
+	}
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if err := validateDirName("service name", name); err != nil {
+			return err
+		}
+		if !servicePattern.MatchString(name) || strings.HasSuffix(name, "-") {
+			return fmt.Errorf("service name %q must be lower case letters, digits and inner hyphens such as %q", name, "order-worker")
+		}
+		if reservedServiceNames[name] {
+			return fmt.Errorf("service name %q is a directory name reserved by the go command", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("service %q is listed twice", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// semverPattern matches a "v"-prefixed semantic version with optional
+// pre-release and build metadata, which covers pseudo-versions and
+// +incompatible versions
+var semverPattern = regexp.MustCompile(`^v(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(-[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+
+// ValidateVersion checks that a dependency version is a valid semantic
+// version before it is written into go.mod
+func ValidateVersion(version string) error {
+	if !semverPattern.MatchString(version) {
+		return fmt.Errorf("version %q is not a valid semantic version such as v1.2.3", version)
+	}
+	return nil
+}
+
+// ValidateModulePath checks a module path against the Go module path rules:
+// the first element must be a lowercase host name containing a dot, and each
+// path element may only use letters, digits and the characters - . _ ~
+func ValidateModulePath(module string) error {
+	if module == "" {
+		return fmt.Errorf("module path is empty")
+	}
+	if strings.HasPrefix(module, "/") || strings.HasSuffix(module, "/") {
+		return fmt.Errorf("module path %q must not start or end with a slash", module)
+	}
+
+	elems := strings.Split(module, "/")
+
+	// The first element is the host and is held to stricter rules
+	host := elems[0]
+	for _, r := range host {
+		if !isHostChar(r) {
+			return fmt.Errorf("module path %q has invalid character %q in host %q (only lowercase letters, digits, '.' and '-' are allowed)", module, r, host)
+		}
+	}
+	if !strings.Contains(host, ".") {
+		return fmt.Errorf("module path %q must begin with a domain name such as github.com", module)
+	}
+	if strings.HasPrefix(host, "-") {
+		return fmt.Errorf("module path %q has a host beginning with '-'", module)
+	}
+
+	for _, elem := range elems {
+		if err := validatePathElement(elem); err != nil {
+			return fmt.Errorf("module path %q: %w", module, err)
+		}
+	}
+
+	return nil
+}
+
+// windowsReservedNames are device names Windows refuses to use as a file or
+// directory name, with or without an extension
+var windowsReservedNames = map[string]bool{
+	"con": true, "prn": true, "aux": true, "nul": true,
+	"com1": true, "com2": true, "com3": true, "com4": true, "com5": true,
+	"com6": true, "com7": true, "com8": true, "com9": true,
+	"lpt1": true, "lpt2": true, "lpt3": true, "lpt4": true, "lpt5": true,
+	"lpt6": true, "lpt7": true, "lpt8": true, "lpt9": true,
+}
+
+// ValidateProjectName checks that a project name is safe to use as the root
+// directory of the generated archive on every platform: letters, digits, '.',
+// '_' and '-', starting with a letter or digit, and no reserved Windows
+// device name
+func ValidateProjectName(name string) error {
+	return validateDirName("project name", name)
+}
+
+// ValidateArchiveRoot checks an explicit archive root directory with the same
+// rules as a project name. Empty (the project name) and "." (no top-level
+// directory) are always valid.
+func ValidateArchiveRoot(root string) error {
+	if root == "" || root == "." {
+		return nil
+	}
+	return validateDirName("archive root", root)
+}
+
+// dirNamePattern matches a directory name without separators, spaces, shell
+// metacharacters or a leading dot
+var dirNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// validateDirName checks that name is a single directory name that is safe on
+// every platform; what names the value in error messages
+func validateDirName(what, name string) error {
+	if name == "" {
+		return fmt.Errorf("%s is empty", what)
+	}
+	if !dirNamePattern.MatchString(name) {
+		return fmt.Errorf("%s %q must start with a letter or digit and contain only letters, digits, '.', '_' and '-'", what, name)
+	}
+	if strings.Contains(name, "..") {
+		return fmt.Errorf("%s %q must not contain '..'", what, name)
+	}
+	if strings.HasSuffix(name, ".") {
+		return fmt.Errorf("%s %q must not end with '.'", what, name)
+	}
+
+	base := strings.ToLower(name)
+	if i := strings.Index(base, "."); i >= 0 {
+		base = base[:i]
+	}
+	if windowsReservedNames[base] {
+		return fmt.Errorf("%s %q is a reserved name on Windows", what, name)
+	}
+
+	return nil
+}
+
+// validatePathElement checks a single slash-separated element of a module path
+func validatePathElement(elem string) error {
+	if elem == "" {
+		return fmt.Errorf("empty path element")
+	}
+	if strings.HasPrefix(elem, ".") || strings.HasSuffix(elem, ".") {
+		return fmt.Errorf("path element %q must not start or end with '.'", elem)
+	}
+	for _, r := range elem {
+		if !isPathChar(r) {
+			return fmt.Errorf("invalid character %q in path element %q", r, elem)
+		}
+	}
+	return nil
+}
+
+func isHostChar(r rune) bool {
+	return ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') || r == '.' || r == '-'
+}
+
+func isPathChar(r rune) bool {
+	return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') ||
+		r == '-' || r == '.' || r == '_' || r == '~'
+}
@@ -0,0 +1,58 @@
+package generator
+
+import "testing"
+
+func TestValidateProjectName(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"myapi", true},
+		{"my-api_v2.1", true},
+		{"2fa", true},
+		{"", false},
+		{".hidden", false},
+		{"-flag", false},
+		{"my api", false},
+		{"a/b", false},
+		{`a\b`, false},
+		{"a..b", false},
+		{"api.", false},
+		{"$(reboot)", false},
+		{"naïve", false},
+		{"con", false},
+		{"LPT1.txt", false},
+	}
+	for _, tt := range tests {
+		err := ValidateProjectName(tt.name)
+		if (err == nil) != tt.valid {
+			t.Errorf("ValidateProjectName(%q) = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
+
+func TestValidateArchiveRoot(t *testing.T) {
+	for _, root := range []string{"", ".", "src", "my-api"} {
+		if err := ValidateArchiveRoot(root); err != nil {
+			t.Errorf("ValidateArchiveRoot(%q) = %v, want nil", root, err)
+		}
+	}
+	for _, root := range []string{"..", "./src", "a b", "nul"} {
+		if err := ValidateArchiveRoot(root); err == nil {
+			t.Errorf("ValidateArchiveRoot(%q) = nil, want an error", root)
+		}
+	}
+}
+
+func TestValidateProjectType(t *testing.T) {
+	for _, c := range [][2]string{{"rest-api", "flat"}, {"library", ""}, {"library", "standard"}, {"grpc", "standard"}, {"grpc", "feature"}, {"cli", "hexagonal"}} {
+		if err := ValidateProjectType(c[0], c[1]); err != nil {
+			t.Errorf("ValidateProjectType(%q, %q) = %v, want nil", c[0], c[1], err)
+		}
+	}
+	for _, c := range [][2]string{{"library", "flat"}, {"grpc", "flat"}, {"grpc", "hexagonal"}, {"grpc", "clean"}} {
+		if err := ValidateProjectType(c[0], c[1]); err == nil {
+			t.Errorf("ValidateProjectType(%q, %q) = nil, want an error", c[0], c[1])
+		}
+	}
+}
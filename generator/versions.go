@@ -0,0 +1,140 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultModuleProxy is queried for the latest version of each dependency
+	defaultModuleProxy = "https://proxy.golang.org"
+
+	// resolveTimeout bounds the total time spent resolving versions for one project
+	resolveTimeout = 5 * time.Second
+)
+
+// versionResolver looks up the latest module versions from a Go module proxy
+// and caches the results for the lifetime of the process
+type versionResolver struct {
+	proxyURL string
+
+	// client is created on first use, so offline generation never builds one
+	clientOnce sync.Once
+	client     *http.Client
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+func newVersionResolver() *versionResolver {
+	return &versionResolver{
+		proxyURL: defaultModuleProxy,
+		cache:    make(map[string]string),
+	}
+}
+
+// resolve replaces the versions in deps with the latest available ones.
+// Any dependency that can't be resolved keeps its pinned version. Canceling
+// parent abandons the outstanding lookups.
+func (r *versionResolver) resolve(parent context.Context, deps map[string]string) {
+	ctx, cancel := context.WithTimeout(parent, resolveTimeout)
+	defer cancel()
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for pkg := range deps {
+		wg.Add(1)
+		go func(pkg string) {
+			defer wg.Done()
+
+			version, err := r.latest(ctx, pkg)
+			if err != nil {
+				if parent.Err() != nil {
+					return // the caller gave up; nobody reads the result
+				}
+				log.Printf("Warning: could not resolve latest version of %s, using pinned version: %v", pkg, err)
+				return
+			}
+
+			mu.Lock()
+			deps[pkg] = version
+			mu.Unlock()
+		}(pkg)
+	}
+	wg.Wait()
+}
+
+// latest returns the latest version of a module, using the cache when possible
+func (r *versionResolver) latest(ctx context.Context, pkg string) (string, error) {
+	r.mu.Lock()
+	version, ok := r.cache[pkg]
+	r.mu.Unlock()
+	if ok {
+		return version, nil
+	}
+
+	url := fmt.Sprintf("%s/%s/@latest", r.proxyURL, escapeModulePath(pkg))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := r.httpClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("proxy returned %s", resp.Status)
+	}
+
+	var info struct {
+		Version string `json:"Version"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", fmt.Errorf("failed to decode proxy response: %w", err)
+	}
+	if info.Version == "" {
+		return "", fmt.Errorf("proxy returned an empty version")
+	}
+
+	r.mu.Lock()
+	r.cache[pkg] = info.Version
+	r.mu.Unlock()
+
+	return info.Version, nil
+}
+
+// httpClient returns the client used for proxy requests, creating it on first use
+func (r *versionResolver) httpClient() *http.Client {
+	r.clientOnce.Do(func() {
+		if r.client == nil {
+			r.client = &http.Client{}
+		}
+	})
+	return r.client
+}
+
+// escapeModulePath applies the module proxy case encoding, where each
+// uppercase letter is replaced by an exclamation mark and its lowercase form
+func escapeModulePath(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if 'A' <= r && r <= 'Z' {
+			b.WriteByte('!')
+			b.WriteRune(r + ('a' - 'A'))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,52 @@
+package generator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOfflineBuildsNoHTTPClient(t *testing.T) {
+	g := newTestGenerator()
+	config := testConfig("standard", "rest-api")
+	config.Router = "chi"
+	config.ResolveLatest = true
+
+	deps := g.getDependencies(context.Background(), config)
+	if deps["github.com/go-chi/chi/v5"] != "v5.0.11" {
+		t.Errorf("chi resolved to %s offline, want the pinned v5.0.11", deps["github.com/go-chi/chi/v5"])
+	}
+	if _, err := g.Generate(config); err != nil {
+		t.Fatal(err)
+	}
+	if g.versions.client != nil {
+		t.Error("offline generation created an HTTP client")
+	}
+}
+
+func TestResolveLatestQueriesProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/github.com/go-chi/chi/v5/@latest" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"Version": "v5.9.9"}`))
+	}))
+	defer proxy.Close()
+
+	g := newTestGenerator()
+	g.versions.proxyURL = proxy.URL
+	config := testConfig("standard", "rest-api")
+	config.Router = "chi"
+	config.ResolveLatest = true
+	config.Offline = false
+
+	deps := g.getDependencies(context.Background(), config)
+	if deps["github.com/go-chi/chi/v5"] != "v5.9.9" {
+		t.Errorf("chi resolved to %s, want v5.9.9 from the proxy", deps["github.com/go-chi/chi/v5"])
+	}
+	if g.versions.client == nil {
+		t.Error("resolving online did not create the HTTP client")
+	}
+}
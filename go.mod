@@ -5,4 +5,5 @@ go 1.25.6
 require (
 	github.com/go-chi/chi/v5 v5.2.5
 	github.com/go-chi/cors v1.2.2
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -3,13 +3,20 @@ package main
 import (
 	"context"
 	"embed"
-	"log"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/thirukguru/go-initializer/generator"
 	"github.com/thirukguru/go-initializer/server"
 )
 
@@ -20,25 +27,108 @@ var webFiles embed.FS
 var projectTemplates embed.FS
 
 func main() {
-	log.Println("Starting Go Initializer...")
+	addrFlag := flag.String("addr", "", "HTTP listen address (overrides PORT, default :8080)")
+	offlineFlag := flag.Bool("offline", false, "never contact the module proxy (same as OFFLINE=true)")
+	versionFlag := flag.Bool("version", false, "print the version and exit")
+	templatesFlag := flag.String("templates", "", "directory of templates overriding the built-in ones (same as TEMPLATE_OVERLAY)")
+	tlsCertFlag := flag.String("tls-cert", "", "TLS certificate file; with -tls-key the server speaks HTTPS (same as TLS_CERT)")
+	tlsKeyFlag := flag.String("tls-key", "", "TLS private key file for -tls-cert (same as TLS_KEY)")
+	verboseFlag := flag.Bool("v", false, "log at debug level, including the body of each generate request (same as LOG_LEVEL=debug)")
+	quietFlag := flag.Bool("quiet", false, "only log warnings and errors (same as LOG_LEVEL=warn)")
+	flag.Parse()
+
+	if *versionFlag {
+		fmt.Println("go-initializer", generator.Version)
+		return
+	}
+
+	// Structured logs; the generator's log.Printf output goes through the same
+	// handler, at warn level since all of it is warnings
+	level, levelErr := resolveLogLevel(*verboseFlag, *quietFlag)
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+	slog.SetLogLoggerLevel(slog.LevelWarn)
+	if levelErr != nil {
+		slog.Warn("Invalid log level, using info", "error", levelErr)
+	}
+
+	slog.Info("Starting Go Initializer...", "version", generator.Version)
+
+	// Validate embedded dependency checksums and the dependency catalog
+	if err := generator.ValidateChecksums(); err != nil {
+		fatal("Invalid checksum table", err)
+	}
+	if err := generator.ValidateCatalog(); err != nil {
+		fatal("Invalid dependency catalog", err)
+	}
+
+	overlays, err := resolveOverlays(*templatesFlag)
+	if err != nil {
+		fatal("Invalid template overlay", err)
+	}
+
+	// Validate that every file mapping has an embedded template and that overridden templates parse
+	if err := generator.New(projectTemplates, overlays...).ValidateTemplates(); err != nil {
+		fatal("Invalid file mappings", err)
+	}
 
 	// Create server
-	srv := server.New(webFiles, projectTemplates)
+	srv := server.New(webFiles, projectTemplates, overlays...)
+	if err := srv.ValidateDependencyPicker(); err != nil {
+		fatal("Invalid dependency picker", err)
+	}
+	if err := server.ValidatePresets(); err != nil {
+		fatal("Invalid preset", err)
+	}
+	if err := configureRateLimit(srv); err != nil {
+		fatal("Invalid rate limit configuration", err)
+	}
+	if v := os.Getenv("ALLOWED_ORIGINS"); v != "" {
+		origins, err := parseOrigins(v)
+		if err != nil {
+			fatal("Invalid ALLOWED_ORIGINS", err)
+		}
+		srv.AllowedOrigins = origins
+	}
+	if v := os.Getenv("GENERATE_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout < 0 {
+			fatal("Invalid GENERATE_TIMEOUT", fmt.Errorf("GENERATE_TIMEOUT must be a non-negative duration such as 10s, got %q", v))
+		}
+		srv.GenerateTimeout = timeout
+	}
+	offline, err := resolveOffline(*offlineFlag)
+	if err != nil {
+		fatal("Invalid OFFLINE", err)
+	}
+	if offline {
+		srv.Offline = true
+		slog.Info("Offline mode: dependency versions and checksums come from the built-in tables")
+	}
+
+	certFile, keyFile, err := resolveTLS(*tlsCertFlag, *tlsKeyFlag)
+	if err != nil {
+		fatal("Invalid TLS configuration", err)
+	}
 
 	// Setup HTTP server
+	addr := resolveAddr(*addrFlag)
 	httpServer := &http.Server{
-		Addr:         ":8080",
+		Addr:         addr,
 		Handler:      srv.Router(),
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
 	}
 
-	// Start server
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		fatal("Server failed to start", err)
+	}
+
+	// Start server; Shutdown below stops either kind of listener
 	go func() {
-		log.Printf("Server starting on http://localhost:8080")
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Server failed to start: %v", err)
+		if err := serve(httpServer, ln, certFile, keyFile); err != nil && err != http.ErrServerClosed {
+			fatal("Server failed to start", err)
 		}
 	}()
 
@@ -47,14 +137,181 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	slog.Info("Shutting down server...")
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	if err := httpServer.Shutdown(ctx); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
+		fatal("Server forced to shutdown", err)
+	}
+
+	slog.Info("Server exited")
+}
+
+// serve accepts connections on ln, speaking HTTPS when a certificate is set
+// and plain HTTP otherwise
+func serve(httpServer *http.Server, ln net.Listener, certFile, keyFile string) error {
+	if certFile != "" {
+		slog.Info("Server starting", "addr", ln.Addr().String(), "tls", true)
+		return httpServer.ServeTLS(ln, certFile, keyFile)
+	}
+	slog.Info("Server starting", "addr", ln.Addr().String(), "tls", false)
+	return httpServer.Serve(ln)
+}
+
+// fatal logs err at error level and exits
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// configureRateLimit applies the RATE_LIMIT (requests per minute per IP, 0
+// disables), RATE_LIMIT_BURST and TRUST_PROXY environment variables
+func configureRateLimit(srv *server.Server) error {
+	if v := os.Getenv("RATE_LIMIT"); v != "" {
+		limit, err := strconv.ParseFloat(v, 64)
+		if err != nil || limit < 0 {
+			return fmt.Errorf("RATE_LIMIT must be a non-negative number, got %q", v)
+		}
+		srv.RateLimit = limit
+	}
+	if v := os.Getenv("RATE_LIMIT_BURST"); v != "" {
+		burst, err := strconv.Atoi(v)
+		if err != nil || burst < 0 {
+			return fmt.Errorf("RATE_LIMIT_BURST must be a non-negative integer, got %q", v)
+		}
+		srv.RateLimitBurst = burst
 	}
+	if v := os.Getenv("TRUST_PROXY"); v != "" {
+		trust, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("TRUST_PROXY must be true or false, got %q", v)
+		}
+		srv.TrustProxy = trust
+	}
+	return nil
+}
+
+// parseOrigins splits a comma-separated list of origins such as
+// "https://example.com, https://*.example.com". Each origin must be a bare
+// http(s) scheme and host; a trailing slash is dropped since browsers never send one.
+func parseOrigins(value string) ([]string, error) {
+	var origins []string
+	for _, origin := range strings.Split(value, ",") {
+		origin = strings.TrimSuffix(strings.TrimSpace(origin), "/")
+		if origin == "" {
+			continue
+		}
 
-	log.Println("Server exited")
+		scheme, host, ok := strings.Cut(origin, "://")
+		if !ok || (scheme != "http" && scheme != "https") {
+			return nil, fmt.Errorf("origin %q must start with http:// or https://", origin)
+		}
+		if host == "" || strings.ContainsAny(host, "/?#") {
+			return nil, fmt.Errorf("origin %q must be a scheme and host without a path", origin)
+		}
+		if strings.Count(origin, "*") > 1 {
+			return nil, fmt.Errorf("origin %q may contain at most one '*'", origin)
+		}
+		origins = append(origins, origin)
+	}
+	if len(origins) == 0 {
+		return nil, fmt.Errorf("no origins in %q", value)
+	}
+	return origins, nil
+}
+
+// resolveLogLevel picks the minimum log level: -v selects debug and -quiet
+// warn, otherwise LOG_LEVEL (debug, info, warn or error) decides. It defaults
+// to info, including when the value is invalid or both flags are set.
+func resolveLogLevel(verbose, quiet bool) (slog.Level, error) {
+	switch {
+	case verbose && quiet:
+		return slog.LevelInfo, fmt.Errorf("-v and -quiet are mutually exclusive")
+	case verbose:
+		return slog.LevelDebug, nil
+	case quiet:
+		return slog.LevelWarn, nil
+	}
+
+	var level slog.Level
+	value := os.Getenv("LOG_LEVEL")
+	if value == "" {
+		return slog.LevelInfo, nil
+	}
+	if err := level.UnmarshalText([]byte(value)); err != nil {
+		return slog.LevelInfo, err
+	}
+	return level, nil
+}
+
+// resolveOffline reports whether offline mode is on: the -offline flag turns
+// it on, otherwise the OFFLINE environment variable decides
+func resolveOffline(flagOffline bool) (bool, error) {
+	if flagOffline {
+		return true, nil
+	}
+	v := os.Getenv("OFFLINE")
+	if v == "" {
+		return false, nil
+	}
+	offline, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("OFFLINE must be true or false, got %q", v)
+	}
+	return offline, nil
+}
+
+// resolveOverlays opens the template overlay directory given by the
+// -templates flag, or else by the TEMPLATE_OVERLAY environment variable
+func resolveOverlays(flagDir string) ([]fs.FS, error) {
+	dir := flagDir
+	if dir == "" {
+		dir = os.Getenv("TEMPLATE_OVERLAY")
+	}
+	if dir == "" {
+		return nil, nil
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	slog.Info("Using template overlay", "dir", dir)
+	return []fs.FS{os.DirFS(dir)}, nil
+}
+
+// resolveTLS returns the certificate and key files to serve HTTPS with: each
+// flag takes precedence over its TLS_CERT or TLS_KEY environment variable.
+// Both empty means plain HTTP; setting only one of them is an error.
+func resolveTLS(flagCert, flagKey string) (certFile, keyFile string, err error) {
+	certFile, keyFile = flagCert, flagKey
+	if certFile == "" {
+		certFile = os.Getenv("TLS_CERT")
+	}
+	if keyFile == "" {
+		keyFile = os.Getenv("TLS_KEY")
+	}
+	if (certFile == "") != (keyFile == "") {
+		return "", "", fmt.Errorf("a TLS certificate and key must be set together")
+	}
+	return certFile, keyFile, nil
+}
+
+// resolveAddr picks the listen address: the -addr flag takes precedence,
+// then the PORT environment variable, then :8080
+func resolveAddr(flagAddr string) string {
+	if flagAddr != "" {
+		return flagAddr
+	}
+	if port := os.Getenv("PORT"); port != "" {
+		if strings.Contains(port, ":") {
+			return port
+		}
+		return ":" + port
+	}
+	return ":8080"
 }
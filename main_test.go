@@ -0,0 +1,204 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/thirukguru/go-initializer/server"
+)
+
+func TestParseOrigins(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{"https://example.com", []string{"https://example.com"}},
+		{" https://example.com/ , http://localhost:3000,", []string{"https://example.com", "http://localhost:3000"}},
+		{"https://*.example.com", []string{"https://*.example.com"}},
+	}
+	for _, tt := range tests {
+		got, err := parseOrigins(tt.value)
+		if err != nil {
+			t.Errorf("parseOrigins(%q) failed: %v", tt.value, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("parseOrigins(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+
+	for _, value := range []string{
+		"",
+		" , ",
+		"example.com",
+		"ftp://example.com",
+		"https://",
+		"https://example.com/app",
+		"https://example.com?x=1",
+		"https://*.*.example.com",
+		"https://example.com, example.org",
+	} {
+		if got, err := parseOrigins(value); err == nil {
+			t.Errorf("parseOrigins(%q) = %q, want an error", value, got)
+		}
+	}
+}
+
+func TestDependencyPickerMatchesCatalog(t *testing.T) {
+	if err := server.New(webFiles, projectTemplates).ValidateDependencyPicker(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestResolveTLS(t *testing.T) {
+	tests := []struct {
+		name              string
+		flagCert, flagKey string
+		envCert, envKey   string
+		wantCert, wantKey string
+		wantErr           bool
+	}{
+		{name: "plain HTTP"},
+		{name: "flags", flagCert: "flag.crt", flagKey: "flag.key", wantCert: "flag.crt", wantKey: "flag.key"},
+		{name: "environment", envCert: "env.crt", envKey: "env.key", wantCert: "env.crt", wantKey: "env.key"},
+		{name: "flags override environment", flagCert: "flag.crt", flagKey: "flag.key", envCert: "env.crt", envKey: "env.key",
+			wantCert: "flag.crt", wantKey: "flag.key"},
+		{name: "flag cert with env key", flagCert: "flag.crt", envKey: "env.key", wantCert: "flag.crt", wantKey: "env.key"},
+		{name: "cert only", flagCert: "flag.crt", wantErr: true},
+		{name: "key only", envKey: "env.key", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TLS_CERT", tt.envCert)
+			t.Setenv("TLS_KEY", tt.envKey)
+			cert, key, err := resolveTLS(tt.flagCert, tt.flagKey)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveTLS() error = %v, want error %v", err, tt.wantErr)
+			}
+			if cert != tt.wantCert || key != tt.wantKey {
+				t.Errorf("resolveTLS() = %q, %q, want %q, %q", cert, key, tt.wantCert, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestServeListener(t *testing.T) {
+	certFile, keyFile := writeTestCert(t)
+	tests := []struct {
+		name              string
+		certFile, keyFile string
+		scheme            string
+	}{
+		{"plain", "", "", "http"},
+		{"tls", certFile, keyFile, "https"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ln, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			httpServer := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if (r.TLS != nil) != (tt.scheme == "https") {
+					w.WriteHeader(http.StatusTeapot)
+				}
+			})}
+			done := make(chan error, 1)
+			go func() { done <- serve(httpServer, ln, tt.certFile, tt.keyFile) }()
+			t.Cleanup(func() {
+				httpServer.Close()
+				if err := <-done; err != http.ErrServerClosed {
+					t.Errorf("serve() = %v, want %v", err, http.ErrServerClosed)
+				}
+			})
+
+			client := &http.Client{Timeout: 5 * time.Second, Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			}}
+			resp, err := client.Get(tt.scheme + "://" + ln.Addr().String() + "/")
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("status %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+		})
+	}
+}
+
+// writeTestCert writes a self-signed certificate for 127.0.0.1 and its key
+// to a temporary directory and returns their paths
+func writeTestCert(t *testing.T) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestIndexHeadSendsCachingHeaders(t *testing.T) {
+	router := server.New(webFiles, projectTemplates).Router()
+
+	get := httptest.NewRecorder()
+	router.ServeHTTP(get, httptest.NewRequest(http.MethodGet, "/", nil))
+	head := httptest.NewRecorder()
+	router.ServeHTTP(head, httptest.NewRequest(http.MethodHead, "/", nil))
+
+	if head.Code != http.StatusOK {
+		t.Fatalf("HEAD /: status %d, want %d", head.Code, http.StatusOK)
+	}
+	if head.Body.Len() != 0 {
+		t.Errorf("HEAD /: %d body bytes, want none", head.Body.Len())
+	}
+	for _, name := range []string{"ETag", "Cache-Control", "Content-Type", "Content-Length"} {
+		if got, want := head.Header().Get(name), get.Header().Get(name); got == "" || got != want {
+			t.Errorf("HEAD /: %s = %q, GET sends %q", name, got, want)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodHead, "/", nil)
+	req.Header.Set("If-None-Match", get.Header().Get("ETag"))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("HEAD / with a matching If-None-Match: status %d, want %d", rec.Code, http.StatusNotModified)
+	}
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// requestLogger logs one line per request once it has been served. It must
+// run after middleware.RequestID so the request ID is available. Health
+// probes arrive every few seconds, so they are only logged at debug level.
+func (s *Server) requestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		level := slog.LevelInfo
+		if r.URL.Path == "/healthz" || r.URL.Path == "/api/health" {
+			level = slog.LevelDebug
+		}
+		s.log(r).Log(r.Context(), level, "Request served",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", ww.Status(),
+			"bytes", ww.BytesWritten(),
+			"duration", time.Since(start),
+			"remote_addr", r.RemoteAddr,
+		)
+	})
+}
+
+// log returns the server logger tagged with the ID of the request being handled
+func (s *Server) log(r *http.Request) *slog.Logger {
+	if id := middleware.GetReqID(r.Context()); id != "" {
+		return s.Logger.With("request_id", id)
+	}
+	return s.Logger
+}
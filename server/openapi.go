@@ -0,0 +1,102 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/thirukguru/go-initializer/generator"
+)
+
+// handleGenerateFromOpenAPI generates a REST API whose handlers are
+// scaffolded from an uploaded OpenAPI document. The multipart/form-data body
+// carries the generate request as JSON in the config field and the document,
+// JSON or YAML, in the spec field.
+func (s *Server) handleGenerateFromOpenAPI(w http.ResponseWriter, r *http.Request) {
+	logger := s.log(r)
+
+	limit := s.MaxBodyBytes + generator.MaxOpenAPISize
+	r.Body = http.MaxBytesReader(w, r.Body, limit)
+	if err := r.ParseMultipartForm(limit); err != nil {
+		logger.Info("Failed to read OpenAPI generate request", "error", err)
+		if isBodyTooLarge(err) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, "Request body too large")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "Expected a multipart/form-data body with config and spec fields")
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	body := []byte(r.FormValue("config"))
+	if len(body) == 0 {
+		writeJSONError(w, http.StatusBadRequest, "The config field with the generate request is required")
+		return
+	}
+	if int64(len(body)) > s.MaxBodyBytes {
+		writeJSONError(w, http.StatusRequestEntityTooLarge, "Request body too large")
+		return
+	}
+	req, ok := s.readGenerateRequest(w, r, body)
+	if !ok {
+		return
+	}
+
+	data, err := readSpecField(r)
+	if err != nil {
+		logger.Info("Failed to read OpenAPI document", "error", err)
+		if errors.Is(err, errSpecTooLarge) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("OpenAPI document too large, the limit is %d KiB", generator.MaxOpenAPISize>>10))
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "Failed to read the OpenAPI document: "+err.Error())
+		return
+	}
+	spec, err := generator.ParseOpenAPI(data)
+	if err != nil {
+		logger.Info("Invalid OpenAPI document", "error", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid OpenAPI document: "+err.Error())
+		return
+	}
+
+	config := s.generateConfig(req)
+	config.OpenAPI = spec
+	if !config.UseOpenAPIHandlers() {
+		writeJSONError(w, http.StatusBadRequest, "Handlers are only generated from an OpenAPI document for rest-api projects in the standard structure")
+		return
+	}
+	s.writeArchive(w, r, config, req.ProjectName)
+}
+
+// errSpecTooLarge is returned by readSpecField for a document over generator.MaxOpenAPISize
+var errSpecTooLarge = errors.New("document too large")
+
+// readSpecField returns the OpenAPI document of a parsed multipart request:
+// the spec file, or the spec field when it was sent as a plain value
+func readSpecField(r *http.Request) ([]byte, error) {
+	file, _, err := r.FormFile("spec")
+	if errors.Is(err, http.ErrMissingFile) {
+		value := r.FormValue("spec")
+		if value == "" {
+			return nil, errors.New("the spec field is missing")
+		}
+		if len(value) > generator.MaxOpenAPISize {
+			return nil, errSpecTooLarge
+		}
+		return []byte(value), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("spec field: %w", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, generator.MaxOpenAPISize+1))
+	if err != nil {
+		return nil, fmt.Errorf("spec field: %w", err)
+	}
+	if len(data) > generator.MaxOpenAPISize {
+		return nil, errSpecTooLarge
+	}
+	return data, nil
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Preset is a named starting point for a generate request. Settings holds
+// request fields in their JSON form; a request naming the preset starts from
+// them and overrides whichever fields it sets itself.
+type Preset struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Settings    json.RawMessage `json:"settings"`
+}
+
+// presets are listed by GET /api/presets in this order
+var presets = []Preset{
+	{
+		Name:        "minimal",
+		Description: "A single main.go serving HTTP with the standard library and no dependencies",
+		Settings: json.RawMessage(`{
+			"structure": "flat",
+			"project_type": "rest-api",
+			"router": "stdlib"
+		}`),
+	},
+	{
+		Name:        "microservice",
+		Description: "Hexagonal REST service on chi with zerolog, Postgres, Prometheus metrics and a Dockerfile",
+		Settings: json.RawMessage(`{
+			"structure": "hexagonal",
+			"project_type": "rest-api",
+			"router": "chi",
+			"logger": "zerolog",
+			"use_logger": true,
+			"use_config": true,
+			"use_docker": true,
+			"use_database": true,
+			"database": "postgres",
+			"dependencies": [
+				{"name": "Prometheus Client", "category": "OBSERVABILITY", "pkg": "github.com/prometheus/client_golang"}
+			]
+		}`),
+	},
+}
+
+// lookupPreset finds a preset by name
+func lookupPreset(name string) (Preset, bool) {
+	for _, p := range presets {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Preset{}, false
+}
+
+// decodeRequest decodes data into v, whose generate request fields are req.
+// When data names a known preset, req starts from the preset's settings, so
+// only the fields present in data override them. An unknown preset is left
+// for validateRequest to report.
+func decodeRequest(data []byte, v any, req *GenerateRequest) error {
+	var named struct {
+		Preset string `json:"preset"`
+	}
+	if err := json.Unmarshal(data, &named); err != nil {
+		return err
+	}
+	if preset, ok := lookupPreset(named.Preset); ok {
+		if err := json.Unmarshal(preset.Settings, req); err != nil {
+			return fmt.Errorf("preset %s: %w", preset.Name, err)
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
+// ValidatePresets checks that every preset decodes into a generate request
+// without validation errors once a project name and module are supplied
+func ValidatePresets() error {
+	for _, preset := range presets {
+		req := GenerateRequest{ProjectName: "preset", Module: "example.com/preset"}
+		if err := json.Unmarshal(preset.Settings, &req); err != nil {
+			return fmt.Errorf("preset %s: %w", preset.Name, err)
+		}
+		for _, issue := range validateRequest(req) {
+			if issue.Severity == severityError {
+				return fmt.Errorf("preset %s: %s: %s", preset.Name, issue.Field, issue.Message)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) handlePresets(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(presets)
+}
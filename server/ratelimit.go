@@ -0,0 +1,129 @@
+package server
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRateLimit is the number of generate, preview, file and share
+	// requests each client IP may make per minute
+	defaultRateLimit = 10
+
+	// limiterSweepInterval is how often buckets of idle clients are dropped
+	limiterSweepInterval = time.Minute
+)
+
+// tokenBucket holds the tokens left for one client as of updated
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// rateLimiter is a token-bucket limiter keyed by client IP. Each bucket
+// refills at perSecond tokens per second up to burst; a request takes one
+// token. Buckets that have refilled completely are swept periodically, since
+// a new bucket behaves the same.
+type rateLimiter struct {
+	perSecond float64
+	burst     float64
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// newRateLimiter allows perMinute requests per minute per key, with bursts of
+// up to burst requests
+func newRateLimiter(perMinute float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		perSecond: perMinute / 60,
+		burst:     float64(burst),
+		buckets:   make(map[string]*tokenBucket),
+		now:       time.Now,
+	}
+}
+
+// buildRateLimiter builds the limiter for the configured rate, or returns nil
+// when rate limiting is disabled
+func (s *Server) buildRateLimiter() *rateLimiter {
+	if s.RateLimit <= 0 {
+		return nil
+	}
+	burst := s.RateLimitBurst
+	if burst <= 0 {
+		burst = int(math.Ceil(s.RateLimit))
+	}
+	return newRateLimiter(s.RateLimit, burst)
+}
+
+// allow takes a token for key. When the bucket is empty it reports how long
+// until the next token is available.
+func (l *rateLimiter) allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) >= limiterSweepInterval {
+		for k, b := range l.buckets {
+			if l.refill(b, now) >= l.burst {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, updated: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.updated = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / l.perSecond * float64(time.Second))
+		return false, wait
+	}
+	b.tokens--
+	return true, 0
+}
+
+// refill returns the tokens in b at now, capped at the burst size
+func (l *rateLimiter) refill(b *tokenBucket, now time.Time) float64 {
+	return math.Min(l.burst, b.tokens+now.Sub(b.updated).Seconds()*l.perSecond)
+}
+
+// rateLimit rejects requests with 429 Too Many Requests once the client IP
+// has used up its allowance. A nil limiter lets every request through.
+func rateLimit(limiter *rateLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ok, wait := limiter.allow(clientIP(r))
+			if !ok {
+				// Retry-After is in whole seconds; round up so retrying on time succeeds
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+				writeJSONError(w, http.StatusTooManyRequests, "Too many requests, please try again later")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIP returns the IP part of the request's remote address
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
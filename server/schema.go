@@ -0,0 +1,231 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/thirukguru/go-initializer/generator"
+)
+
+// JSONSchema is the subset of JSON Schema (draft 2020-12) used to describe
+// GenerateRequest. Type is a single type name, or a list of them for
+// nullable fields.
+type JSONSchema struct {
+	Schema      string                 `json:"$schema,omitempty"`
+	Title       string                 `json:"title,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Type        any                    `json:"type"`
+	Enum        []string               `json:"enum,omitempty"`
+	Minimum     *int                   `json:"minimum,omitempty"`
+	Maximum     *int                   `json:"maximum,omitempty"`
+	Properties  map[string]*JSONSchema `json:"properties,omitempty"`
+	Required    []string               `json:"required,omitempty"`
+	Items       *JSONSchema            `json:"items,omitempty"`
+}
+
+// schemaEnums lists the accepted values of the string fields that take one
+// of a fixed set; the empty string selects the field's default
+func schemaEnums() map[string][]string {
+	options := generator.GetOptions()
+	withDefault := func(values []string) []string {
+		return append([]string{""}, values...)
+	}
+	return map[string][]string{
+		"structure":          withDefault(options.Structures),
+		"project_type":       withDefault(options.ProjectTypes),
+		"router":             withDefault(options.Routers),
+		"logger":             withDefault(options.Loggers),
+		"config_style":       {"", "env", "viper"},
+		"license":            {"", "mit", "apache-2.0", "gpl-3.0", "bsd-3-clause", "none"},
+		"ci_provider":        {"", "github", "gitlab", "circleci", "none"},
+		"platform_target":    {"", "heroku", "railway", "none"},
+		"use_dependency_bot": {"", "dependabot", "renovate", "none"},
+		"database":           {"", "postgres", "mysql", "sqlite"},
+	}
+}
+
+// GenerateRequestSchema returns the JSON Schema of the body of
+// /api/generate, built from the fields of GenerateRequest so the two can't
+// drift apart. Fields other than project_name and module may be left out.
+func GenerateRequestSchema() *JSONSchema {
+	schema := schemaFor(reflect.TypeFor[GenerateRequest](), schemaEnums())
+	schema.Schema = "https://json-schema.org/draft/2020-12/schema"
+	schema.Title = "GenerateRequest"
+	schema.Description = "Body of POST /api/generate, /api/preview, /api/file and /api/validate"
+	schema.Required = []string{"project_name", "module"}
+
+	minPort, maxPort := 0, 65535
+	schema.Properties["port"].Minimum = &minPort
+	schema.Properties["port"].Maximum = &maxPort
+	return schema
+}
+
+// schemaFor describes a Go type the way encoding/json decodes it. Struct
+// fields are named by their json tag, and a string field listed in enums
+// only accepts those values.
+func schemaFor(t reflect.Type, enums map[string][]string) *JSONSchema {
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := schemaFor(t.Elem(), enums)
+		schema.Type = []string{schema.Type.(string), "null"}
+		return schema
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Slice:
+		return &JSONSchema{Type: "array", Items: schemaFor(t.Elem(), enums)}
+	case reflect.Struct:
+		schema := &JSONSchema{Type: "object", Properties: make(map[string]*JSONSchema)}
+		for i := range t.NumField() {
+			field := t.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			prop := schemaFor(field.Type, enums)
+			if field.Type.Kind() == reflect.String {
+				prop.Enum = enums[name]
+			}
+			schema.Properties[name] = prop
+		}
+		return schema
+	}
+	panic(fmt.Sprintf("no JSON schema for %s", t))
+}
+
+// validateSchema checks a request body against schema and returns an error
+// issue for each field that doesn't match it, naming the field by its path
+// such as "dependencies[1].version". Properties the schema doesn't list are
+// allowed, as encoding/json ignores them.
+func validateSchema(schema *JSONSchema, body []byte) []ValidationIssue {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return []ValidationIssue{{Field: "", Message: "Body is not valid JSON: " + err.Error(), Severity: severityError}}
+	}
+	var issues []ValidationIssue
+	checkSchema(schema, "", value, &issues)
+	return issues
+}
+
+// checkSchema appends to issues every mismatch between value and schema;
+// path names value in the messages
+func checkSchema(schema *JSONSchema, path string, value any, issues *[]ValidationIssue) {
+	fail := func(message string) {
+		*issues = append(*issues, ValidationIssue{Field: path, Message: message, Severity: severityError})
+	}
+
+	types, ok := schema.Type.([]string)
+	if !ok {
+		types = []string{schema.Type.(string)}
+	}
+	if value == nil {
+		if !slices.Contains(types, "null") {
+			fail("must be " + article(types[0]) + ", not null")
+		}
+		return
+	}
+
+	switch types[0] {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			fail("must be a string")
+			return
+		}
+		if schema.Enum != nil && !slices.Contains(schema.Enum, s) {
+			values := slices.DeleteFunc(slices.Clone(schema.Enum), func(v string) bool { return v == "" })
+			fail(fmt.Sprintf("%q is not one of %s", s, strings.Join(values, ", ")))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			fail("must be a boolean")
+		}
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			fail("must be an integer")
+			return
+		}
+		i, err := n.Int64()
+		if err != nil {
+			fail("must be an integer, got " + n.String())
+			return
+		}
+		if schema.Minimum != nil && i < int64(*schema.Minimum) {
+			fail(fmt.Sprintf("must be at least %d", *schema.Minimum))
+		}
+		if schema.Maximum != nil && i > int64(*schema.Maximum) {
+			fail(fmt.Sprintf("must be at most %d", *schema.Maximum))
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			fail("must be an array")
+			return
+		}
+		for i, item := range items {
+			checkSchema(schema.Items, fmt.Sprintf("%s[%d]", path, i), item, issues)
+		}
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			fail("must be an object")
+			return
+		}
+		for _, name := range schema.Required {
+			if _, ok := object[name]; !ok {
+				*issues = append(*issues, ValidationIssue{Field: joinPath(path, name), Message: "is required", Severity: severityError})
+			}
+		}
+		// Sorted, so the issues come out in the same order every time
+		names := make([]string, 0, len(schema.Properties))
+		for name := range schema.Properties {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			if v, ok := object[name]; ok {
+				checkSchema(schema.Properties[name], joinPath(path, name), v, issues)
+			}
+		}
+	}
+}
+
+// joinPath names the property of the object at path
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// article prefixes a JSON type name with "a" or "an"
+func article(typ string) string {
+	if strings.ContainsAny(typ[:1], "aeiou") {
+		return "an " + typ
+	}
+	return "a " + typ
+}
+
+// schemaMessage formats a schema issue as the plain error of /api/generate
+func schemaMessage(issue ValidationIssue) string {
+	if issue.Field == "" {
+		return issue.Message
+	}
+	return "Invalid request: " + issue.Field + " " + issue.Message
+}
+
+func (s *Server) handleSchema(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/schema+json")
+	json.NewEncoder(w).Encode(GenerateRequestSchema())
+}
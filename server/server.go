@@ -2,12 +2,16 @@ package server
 
 import (
 	"bytes"
+	"context"
 	"embed"
 	"encoding/json"
+	"errors"
 	"io"
 	"io/fs"
-	"log"
+	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -15,17 +19,93 @@ import (
 	"github.com/thirukguru/go-initializer/generator"
 )
 
+// defaultAllowedOrigins lets any web page call the API, which is what the public instance wants
+var defaultAllowedOrigins = []string{"https://*", "http://*"}
+
+const (
+	// defaultPreviewContentLimit caps the per-file content returned by the preview endpoint
+	defaultPreviewContentLimit = 64 * 1024
+
+	// defaultMaxBodyBytes caps the size of generate and preview request bodies
+	defaultMaxBodyBytes = 1 << 20
+
+	// defaultGenerateTimeout bounds rendering one project, which stays well
+	// below a second unless resolve_latest waits on the module proxy
+	defaultGenerateTimeout = 10 * time.Second
+)
+
+// renderer renders the files of a project. *generator.Generator implements
+// it; tests swap in slow or failing renderers.
+type renderer interface {
+	RenderFilesContext(ctx context.Context, config generator.ProjectConfig) ([]generator.GeneratedFile, error)
+	RenderFileContext(ctx context.Context, config generator.ProjectConfig, path string) (generator.GeneratedFile, error)
+}
+
 type Server struct {
 	webFiles         embed.FS
 	projectTemplates embed.FS
-	generator        *generator.Generator
+	generator        renderer
+
+	// PreviewContentLimit is the maximum number of bytes of each file
+	// returned by /api/preview?content=true
+	PreviewContentLimit int
+
+	// MaxBodyBytes is the maximum accepted request body size for generate, preview and share
+	MaxBodyBytes int64
+
+	// ShareTTL is how long a configuration saved with POST /api/share can be fetched
+	ShareTTL time.Duration
+
+	// GenerateTimeout bounds how long rendering a project for generate,
+	// preview and file requests may take, module proxy lookups included.
+	// Requests that run over get 504 Gateway Timeout; zero disables the limit.
+	GenerateTimeout time.Duration
+
+	// Logger receives request and error logs; each entry carries the request ID
+	Logger *slog.Logger
+
+	// RateLimit is the number of generate, preview, file and share requests a
+	// client IP may make per minute; zero disables rate limiting
+	RateLimit float64
+
+	// RateLimitBurst is how many of those requests may be made back to back;
+	// zero means the same as RateLimit
+	RateLimitBurst int
+
+	// AllowedOrigins lists the origins allowed to call the API from a browser.
+	// Entries may contain one "*" wildcard, e.g. "https://*.example.com".
+	AllowedOrigins []string
+
+	// TrustProxy takes the client IP from X-Forwarded-For / X-Real-IP, which
+	// is needed behind a reverse proxy but lets clients pick their own IP otherwise
+	TrustProxy bool
+
+	// Offline generates every project without network access: resolve_latest
+	// is ignored and the module proxy is never contacted
+	Offline bool
+
+	shares *shareStore
+
+	// schema is the JSON Schema generate requests are checked against
+	schema *JSONSchema
 }
 
-func New(webFiles, projectTemplates embed.FS) *Server {
+// New returns a server rendering projectTemplates; templates in overlays take
+// precedence over them as described for generator.New
+func New(webFiles, projectTemplates embed.FS, overlays ...fs.FS) *Server {
 	return &Server{
-		webFiles:         webFiles,
-		projectTemplates: projectTemplates,
-		generator:        generator.New(projectTemplates),
+		webFiles:            webFiles,
+		projectTemplates:    projectTemplates,
+		generator:           generator.New(projectTemplates, overlays...),
+		PreviewContentLimit: defaultPreviewContentLimit,
+		MaxBodyBytes:        defaultMaxBodyBytes,
+		ShareTTL:            defaultShareTTL,
+		GenerateTimeout:     defaultGenerateTimeout,
+		Logger:              slog.Default(),
+		RateLimit:           defaultRateLimit,
+		AllowedOrigins:      defaultAllowedOrigins,
+		shares:              newShareStore(),
+		schema:              GenerateRequestSchema(),
 	}
 }
 
@@ -33,32 +113,62 @@ func (s *Server) Router() http.Handler {
 	r := chi.NewRouter()
 
 	// Middleware
-	r.Use(middleware.Logger)
-	r.Use(middleware.Recoverer)
 	r.Use(middleware.RequestID)
+	if s.TrustProxy {
+		r.Use(middleware.RealIP)
+	}
+	r.Use(s.requestLogger)
+	r.Use(middleware.Recoverer)
 	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"https://*", "http://*"},
+		AllowedOrigins:   s.AllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
 		AllowedHeaders:   []string{"Accept", "Content-Type"},
+		ExposedHeaders:   []string{"Content-Disposition", "X-File-Count", "X-Uncompressed-Size"},
 		AllowCredentials: false,
 		MaxAge:           300,
 	}))
 
 	// Serve static files
 	staticFS, err := fs.Sub(s.webFiles, "web/static")
+	if err == nil {
+		var static http.Handler
+		static, err = staticHandler(staticFS)
+		if err == nil {
+			r.Handle("/static/*", http.StripPrefix("/static/", static))
+		}
+	}
 	if err != nil {
-		log.Printf("Warning: Could not load static files: %v", err)
-	} else {
-		r.Handle("/static/*", http.StripPrefix("/static/", http.FileServer(http.FS(staticFS))))
+		s.Logger.Warn("Could not load static files", "error", err)
 	}
 
-	// Serve the main HTML page
+	// Serve the main HTML page; HEAD gets the same caching headers
 	r.Get("/", s.handleIndex)
+	r.Head("/", s.handleIndex)
+
+	// Liveness probe for load balancers and Kubernetes; same as /api/health
+	r.Get("/healthz", s.handleHealth)
 
 	// API routes
+	limiter := s.buildRateLimiter()
 	r.Route("/api", func(r chi.Router) {
-		r.Post("/generate", s.handleGenerate)
-		r.Post("/preview", s.handlePreview)
+		// Rendering is the expensive part and shares take memory until they
+		// expire, so only these are throttled
+		r.Group(func(r chi.Router) {
+			r.Use(rateLimit(limiter))
+			r.Post("/generate", s.handleGenerate)
+			r.Post("/preview", s.handlePreview)
+			r.Post("/file", s.handleFile)
+			r.Post("/generate-from-openapi", s.handleGenerateFromOpenAPI)
+			r.Post("/share", s.handleCreateShare)
+		})
+		r.Get("/options", s.handleOptions)
+		r.Get("/presets", s.handlePresets)
+		r.Get("/schema", s.handleSchema)
+		r.Get("/version", s.handleVersion)
+		r.Get("/health", s.handleHealth)
+		r.Get("/share/{id}", s.handleGetShare)
+		r.Get("/decode", s.handleDecode)
+		r.Post("/validate", s.handleValidate)
 	})
 
 	return r
@@ -71,8 +181,11 @@ func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// ServeContent answers If-None-Match with 304 Not Modified
 	w.Header().Set("Content-Type", "text/html")
-	w.Write(data)
+	w.Header().Set("ETag", contentETag(data))
+	w.Header().Set("Cache-Control", indexCacheControl)
+	http.ServeContent(w, r, "index.html", time.Time{}, bytes.NewReader(data))
 }
 
 type Dependency struct {
@@ -80,13 +193,20 @@ type Dependency struct {
 	Category string `json:"category"`
 	Desc     string `json:"desc"`
 	Pkg      string `json:"pkg"`
+	Version  string `json:"version"` // Optional; overrides the catalog version
 }
 type GenerateRequest struct {
+	// Preset names an entry of GET /api/presets whose settings fill every
+	// field the request doesn't set
+	Preset string `json:"preset,omitempty"`
+
 	// Core
 	ProjectName string `json:"project_name"`
 	Module      string `json:"module"`
 	Description string `json:"description"`
 	GoVersion   string `json:"go_version"`
+	Author      string `json:"author"`
+	AuthorEmail string `json:"author_email"`
 
 	// Structure
 	Structure   string `json:"structure"`
@@ -96,6 +216,20 @@ type GenerateRequest struct {
 	Router string `json:"router"`
 	Logger string `json:"logger"`
 
+	// HTTP port of the generated service; 0 means 8080
+	Port int `json:"port"`
+
+	// Name of the sample feature in the feature layout; empty means "user"
+	FeatureName string `json:"feature_name"`
+
+	// Features of the feature layout, each with its own package under
+	// internal/; overrides feature_name when set
+	Features []string `json:"features"`
+
+	// Services of a monorepo, each built from cmd/<service>; only applies
+	// to rest-api projects in the standard structure
+	Services []string `json:"services"`
+
 	// Optional Features
 	UseDocker   bool `json:"use_docker"`
 	UseGitHub   bool `json:"use_github"`
@@ -105,46 +239,210 @@ type GenerateRequest struct {
 	UseRedis    bool `json:"use_redis"`
 	UseJWT      bool `json:"use_jwt"`
 	UseAir      bool `json:"use_air"`
+	UseLinter   bool `json:"use_linter"`
+
+	// Generate Kubernetes manifests; only applies together with use_docker
+	UseKubernetes bool `json:"use_kubernetes"`
+
+	// Generate .editorconfig and .pre-commit-config.yaml
+	UseDevTooling bool `json:"use_dev_tooling"`
+
+	// How the config package loads settings: "env" (default) or "viper",
+	// which also reads config.yaml
+	ConfigStyle string `json:"config_style"`
+
+	// Load .env into the environment at startup with godotenv
+	UseDotenv bool `json:"use_dotenv"`
+
+	// LICENSE file: "mit", "apache-2.0", "gpl-3.0", "bsd-3-clause" or "none"
+	License string `json:"license"`
+
+	// CI pipeline: "github", "gitlab", "circleci" or "none"; use_github is an alias for "github"
+	CIProvider string `json:"ci_provider"`
+
+	// PaaS deploy descriptor: "heroku" (Procfile), "railway" (railway.toml) or "none"
+	PlatformTarget string `json:"platform_target"`
+
+	// Dependency update bot: "dependabot" (.github/dependabot.yml, needs
+	// GitHub CI), "renovate" (renovate.json) or "none"
+	UseDependencyBot string `json:"use_dependency_bot"`
+
+	// Write a CycloneDX SBOM of the build in CI; only applies with a CI provider
+	UseSBOM bool `json:"use_sbom"`
+
+	// Branch the CI pipeline runs on and the README's CI badge reports on; default "main"
+	DefaultBranch string `json:"default_branch"`
+
+	// Database driver: "postgres" (default), "mysql" or "sqlite"
+	Database string `json:"database"`
+
+	// Generate sqlc configuration and queries; only applies to postgres
+	UseSqlc bool `json:"use_sqlc"`
+
+	// Generate an OpenAPI spec and serve Swagger UI; only applies to rest-api projects
+	UseSwagger bool `json:"use_swagger"`
+
+	// Generate buf.yaml and buf.gen.yaml; only applies to grpc projects
+	UseBuf bool `json:"use_buf"`
 
 	// Dependencies array
 	Dependencies []Dependency `json:"dependencies"`
+
+	// Resolve the newest dependency versions from the module proxy
+	ResolveLatest bool `json:"resolve_latest"`
+
+	// Add a .go-initializer.json manifest recording these options
+	IncludeManifest bool `json:"include_manifest"`
+
+	// Top-level directory of the archive; omitted means project_name and an
+	// empty string puts the files at the top level
+	ArchiveRoot *string `json:"archive_root"`
 }
 
 func (s *Server) handleGenerate(w http.ResponseWriter, r *http.Request) {
-	// Read body fully first
+	logger := s.log(r)
+
+	// Read body fully first so it can be logged when debugging
+	r.Body = http.MaxBytesReader(w, r.Body, s.MaxBodyBytes)
 	bodyBytes, err := io.ReadAll(r.Body)
 	if err != nil {
-		log.Printf("Failed to read body: %v", err)
-		http.Error(w, "Failed to read request", http.StatusBadRequest)
+		logger.Warn("Failed to read generate request body", "error", err)
+		if isBodyTooLarge(err) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, "Request body too large")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "Failed to read request")
 		return
 	}
-	defer r.Body.Close()
-	r.Body = io.NopCloser(bytes.NewReader(bodyBytes)) // Reset for decode
+	logger.Debug("Generate request body", "body", string(bodyBytes))
+
+	req, ok := s.readGenerateRequest(w, r, bodyBytes)
+	if !ok {
+		return
+	}
+	s.writeArchive(w, r, s.generateConfig(req), req.ProjectName)
+}
+
+// readGenerateRequest decodes and validates the generate request in body.
+// An invalid request is answered with 400 and ok is false.
+func (s *Server) readGenerateRequest(w http.ResponseWriter, r *http.Request, body []byte) (req GenerateRequest, ok bool) {
+	logger := s.log(r)
+
+	// Check the shape of the body first, so a wrongly typed field is named
+	if issues := validateSchema(s.schema, body); len(issues) > 0 {
+		logger.Info("Invalid generate request", "field", issues[0].Field, "error", issues[0].Message)
+		writeJSONError(w, http.StatusBadRequest, schemaMessage(issues[0]))
+		return req, false
+	}
 
-	log.Printf("Raw body: %s", bodyBytes) // This WILL print
+	if err := decodeRequest(body, &req, &req); err != nil {
+		logger.Info("Invalid generate request", "error", err)
+		writeJSONError(w, http.StatusBadRequest, "Invalid request")
+		return req, false
+	}
 
-	var req GenerateRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Printf("Decode error: %v", err)
-		http.Error(w, "Invalid request", http.StatusBadRequest)
+	// Validate request; warnings don't block generation
+	for _, issue := range validateRequest(req) {
+		if issue.Severity == severityError {
+			writeJSONError(w, http.StatusBadRequest, issue.Message)
+			return req, false
+		}
+	}
+	return req, true
+}
+
+// generateContext returns the context to render a project for r with: the
+// request's context, cut off after GenerateTimeout
+func (s *Server) generateContext(r *http.Request) (context.Context, context.CancelFunc) {
+	if s.GenerateTimeout <= 0 {
+		return context.WithCancel(r.Context())
+	}
+	return context.WithTimeout(r.Context(), s.GenerateTimeout)
+}
+
+// writeArchive renders config and streams it as the archive name, in the
+// format the request's format parameter picks
+func (s *Server) writeArchive(w http.ResponseWriter, r *http.Request, config generator.ProjectConfig, name string) {
+	logger := s.log(r)
+	logger.Debug("Generating project",
+		"project_name", config.ProjectName,
+		"structure", config.Structure,
+		"project_type", config.ProjectType,
+		"router", config.Router,
+		"dependencies", config.Dependencies,
+	)
+
+	// Pick the archive format (zip by default)
+	write := generator.WriteZip
+	contentType := "application/zip"
+	extension := ".zip"
+	switch r.URL.Query().Get("format") {
+	case "", "zip":
+	case "targz":
+		write = generator.WriteTarGz
+		contentType = "application/gzip"
+		extension = ".tar.gz"
+	default:
+		writeJSONError(w, http.StatusBadRequest, "Unsupported format, expected zip or targz")
 		return
 	}
 
-	log.Printf("Received generate request: %+v", req) // Full req now logs
-	// Validate request
-	if req.ProjectName == "" {
-		http.Error(w, "Project name is required", http.StatusBadRequest)
+	// Render before streaming, so the headers can describe the archive
+	ctx, cancel := s.generateContext(r)
+	defer cancel()
+	files, err := s.generator.RenderFilesContext(ctx, config)
+	if err != nil {
+		if r.Context().Err() != nil {
+			// The client went away; there is nobody left to answer
+			logger.Info("Generate request canceled", "error", err)
+			return
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			logger.Warn("Generate request timed out", "timeout", s.GenerateTimeout)
+			writeJSONError(w, http.StatusGatewayTimeout, "Generating the project took too long")
+			return
+		}
+		logger.Error("Failed to generate project", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to generate project")
 		return
 	}
-	if req.Module == "" {
-		http.Error(w, "Module path is required", http.StatusBadRequest)
+	size := 0
+	for _, file := range files {
+		size += len(file.Content)
+	}
+
+	// Stream the archive straight into the response
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+name+extension)
+	w.Header().Set("X-File-Count", strconv.Itoa(len(files)))
+	w.Header().Set("X-Uncompressed-Size", strconv.Itoa(size))
+	out := &countingWriter{w: w}
+	if err := write(out, config, files); err != nil {
+		if r.Context().Err() != nil {
+			logger.Info("Generate request canceled", "error", err, "bytes_written", out.n)
+			return
+		}
+		logger.Error("Failed to write archive", "error", err, "bytes_written", out.n)
+		// Once part of the archive is out the status can't change; the
+		// client sees a truncated download instead
+		if out.n == 0 {
+			w.Header().Del("Content-Disposition")
+			w.Header().Del("X-File-Count")
+			w.Header().Del("X-Uncompressed-Size")
+			writeJSONError(w, http.StatusInternalServerError, "Failed to generate project")
+		}
 		return
 	}
+}
 
+// generateConfig applies the defaults of /api/generate to a validated
+// request and converts it to a generator config
+func (s *Server) generateConfig(req GenerateRequest) generator.ProjectConfig {
 	// Set defaults
 	if req.GoVersion == "" {
 		req.GoVersion = "1.26.0"
 	}
+	req.GoVersion, _ = generator.NormalizeGoVersion(req.GoVersion) // validated by validateRequest
 	if req.Structure == "" {
 		req.Structure = "standard"
 	}
@@ -154,44 +452,108 @@ func (s *Server) handleGenerate(w http.ResponseWriter, r *http.Request) {
 	if req.Router == "" {
 		req.Router = "chi"
 	}
+	if req.Database == "" {
+		req.Database = "postgres"
+	}
+	archiveRoot := "" // the generator defaults it to the project name
+	if req.ArchiveRoot != nil {
+		archiveRoot = *req.ArchiveRoot
+		if archiveRoot == "" {
+			archiveRoot = "."
+		}
+	}
 
 	// Convert to generator config
 	config := generator.ProjectConfig{
-		ProjectName:  req.ProjectName,
-		Module:       req.Module,
-		Description:  req.Description,
-		GoVersion:    req.GoVersion,
-		Structure:    req.Structure,
-		ProjectType:  req.ProjectType,
-		Router:       req.Router,
-		Logger:       req.Logger,
-		UseDocker:    req.UseDocker,
-		UseGitHub:    req.UseGitHub,
-		UseConfig:    req.UseConfig,
-		UseLogger:    req.UseLogger,
-		UseDatabase:  req.UseDatabase,
-		UseRedis:     req.UseRedis,
-		UseJWT:       req.UseJWT,
-		UseAir:       req.UseAir,
-		Dependencies: []string{}, // Empty slice
+		ProjectName:      req.ProjectName,
+		PackageName:      generator.PackageName(req.ProjectName),
+		Module:           req.Module,
+		Description:      req.Description,
+		GoVersion:        req.GoVersion,
+		Author:           req.Author,
+		AuthorEmail:      req.AuthorEmail,
+		Structure:        req.Structure,
+		ProjectType:      req.ProjectType,
+		Router:           req.Router,
+		Logger:           req.Logger,
+		Port:             req.Port,
+		FeatureName:      req.FeatureName,
+		Features:         req.Features,
+		Services:         req.Services,
+		UseDocker:        req.UseDocker,
+		UseGitHub:        req.UseGitHub,
+		UseConfig:        req.UseConfig,
+		UseLogger:        req.UseLogger,
+		UseDatabase:      req.UseDatabase,
+		UseRedis:         req.UseRedis,
+		UseJWT:           req.UseJWT,
+		UseAir:           req.UseAir,
+		UseLinter:        req.UseLinter,
+		UseKubernetes:    req.UseKubernetes,
+		UseDevTooling:    req.UseDevTooling,
+		ConfigStyle:      req.ConfigStyle,
+		UseDotenv:        req.UseDotenv,
+		License:          req.License,
+		CIProvider:       req.CIProvider,
+		PlatformTarget:   req.PlatformTarget,
+		UseDependencyBot: req.UseDependencyBot,
+		UseSBOM:          req.UseSBOM,
+		DefaultBranch:    req.DefaultBranch,
+		Database:         req.Database,
+		UseSqlc:          req.UseSqlc,
+		UseSwagger:       req.UseSwagger,
+		UseBuf:           req.UseBuf,
+		Dependencies:     []string{}, // Empty slice
+		ResolveLatest:    req.ResolveLatest,
+		Offline:          s.Offline,
+		IncludeManifest:  req.IncludeManifest,
+		ArchiveRoot:      archiveRoot,
 	}
 
 	for _, dep := range req.Dependencies {
 		config.Dependencies = append(config.Dependencies, dep.Pkg)
+		if dep.Version != "" {
+			if config.DependencyVersions == nil {
+				config.DependencyVersions = make(map[string]string)
+			}
+			config.DependencyVersions[dep.Pkg] = dep.Version
+		}
 	}
 
-	log.Printf("Extracted deps: %v", config.Dependencies)
-	zipData, err := s.generator.Generate(config)
-	if err != nil {
-		log.Printf("Error generating project: %v", err)
-		http.Error(w, "Failed to generate project", http.StatusInternalServerError)
-		return
-	}
+	return config
+}
 
-	// Send zip file
-	w.Header().Set("Content-Type", "application/zip")
-	w.Header().Set("Content-Disposition", "attachment; filename="+req.ProjectName+".zip")
-	w.Write(zipData)
+// countingWriter records how many bytes have been written through it, so a
+// handler can tell whether a response has already started
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// ErrorResponse is the body of every API error response
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// writeJSONError replies to an API request with status and an ErrorResponse
+// carrying message. Like http.Error, it replaces any Content-Type set so far.
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Error: message})
+}
+
+// isBodyTooLarge reports whether err was caused by exceeding the MaxBytesReader limit
+func isBodyTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
 }
 
 type PreviewResponse struct {
@@ -199,59 +561,168 @@ type PreviewResponse struct {
 }
 
 type FilePreview struct {
-	Path string `json:"path"`
-	Size int    `json:"size"`
+	Path      string `json:"path"`
+	Size      int    `json:"size"`
+	Content   string `json:"content,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
 }
 
 func (s *Server) handlePreview(w http.ResponseWriter, r *http.Request) {
-	var req GenerateRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request", http.StatusBadRequest)
+	r.Body = http.MaxBytesReader(w, r.Body, s.MaxBodyBytes)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		if isBodyTooLarge(err) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, "Request body too large")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "Failed to read request")
+		return
+	}
+	// Same validation and defaults as /api/generate, so the preview matches the archive
+	req, ok := s.readGenerateRequest(w, r, body)
+	if !ok {
 		return
 	}
+	config := s.generateConfig(req)
 
-	// Set defaults
-	if req.Structure == "" {
-		req.Structure = "standard"
+	// Render files to report their real sizes
+	ctx, cancel := s.generateContext(r)
+	defer cancel()
+	files, err := s.generator.RenderFilesContext(ctx, config)
+	if err != nil {
+		if r.Context().Err() != nil {
+			s.log(r).Info("Preview request canceled", "error", err)
+			return
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			s.log(r).Warn("Preview request timed out", "timeout", s.GenerateTimeout)
+			writeJSONError(w, http.StatusGatewayTimeout, "Rendering the preview took too long")
+			return
+		}
+		s.log(r).Error("Failed to render preview", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to render preview")
+		return
 	}
 
-	// Convert to generator config
-	config := generator.ProjectConfig{
-		ProjectName:  req.ProjectName,
-		Module:       req.Module,
-		Description:  req.Description,
-		GoVersion:    req.GoVersion,
-		Structure:    req.Structure,
-		ProjectType:  req.ProjectType,
-		Router:       req.Router,
-		Logger:       req.Logger,
-		UseDocker:    req.UseDocker,
-		UseGitHub:    req.UseGitHub,
-		UseConfig:    req.UseConfig,
-		UseLogger:    req.UseLogger,
-		UseDatabase:  req.UseDatabase,
-		UseRedis:     req.UseRedis,
-		UseJWT:       req.UseJWT,
-		UseAir:       req.UseAir,
-		Dependencies: make([]string, len(req.Dependencies)),
-	}
-	for i, dep := range req.Dependencies {
-		config.Dependencies[i] = dep.Pkg // Use actual import path
-	}
-
-	// Get file list
-	files := s.generator.GetFileList(config)
+	includeContent := r.URL.Query().Get("content") == "true"
 
 	var previews []FilePreview
 	for _, file := range files {
-		previews = append(previews, FilePreview{
-			Path: file,
-			Size: 0, // Size would be calculated if needed
-		})
+		preview := FilePreview{
+			Path: file.Path,
+			Size: len(file.Content),
+		}
+		if includeContent {
+			content := file.Content
+			if len(content) > s.PreviewContentLimit {
+				content = content[:s.PreviewContentLimit]
+				preview.Truncated = true
+			}
+			preview.Content = string(content)
+		}
+		previews = append(previews, preview)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Query().Get("tree") == "true" {
+		json.NewEncoder(w).Encode(PreviewTreeResponse{
+			Tree: buildFileTree(previews),
+		})
+		return
+	}
 	json.NewEncoder(w).Encode(PreviewResponse{
 		Files: previews,
 	})
 }
+
+// FileRequest is the body of POST /api/file: a generate request plus the
+// path, relative to the project root, of the one file to return
+type FileRequest struct {
+	GenerateRequest
+	Path string `json:"path"`
+}
+
+func (s *Server) handleFile(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, s.MaxBodyBytes)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		if isBodyTooLarge(err) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, "Request body too large")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "Failed to read request")
+		return
+	}
+	// Same validation and defaults as /api/generate, so the file matches the archive
+	generateReq, ok := s.readGenerateRequest(w, r, body)
+	if !ok {
+		return
+	}
+	var req FileRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid request")
+		return
+	}
+	req.GenerateRequest = generateReq
+	if req.Path == "" {
+		writeJSONError(w, http.StatusBadRequest, "path is required")
+		return
+	}
+	config := s.generateConfig(req.GenerateRequest)
+
+	ctx, cancel := s.generateContext(r)
+	defer cancel()
+	file, err := s.generator.RenderFileContext(ctx, config, req.Path)
+	if errors.Is(err, generator.ErrFileNotGenerated) {
+		writeJSONError(w, http.StatusNotFound, req.Path+" is not generated for this configuration")
+		return
+	}
+	if err != nil && r.Context().Err() != nil {
+		s.log(r).Info("File request canceled", "path", req.Path, "error", err)
+		return
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		s.log(r).Warn("File request timed out", "path", req.Path, "timeout", s.GenerateTimeout)
+		writeJSONError(w, http.StatusGatewayTimeout, "Rendering the file took too long")
+		return
+	}
+	if err != nil {
+		s.log(r).Error("Failed to render file", "path", req.Path, "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to render file")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Write(file.Content)
+}
+
+func (s *Server) handleOptions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(generator.GetOptions())
+}
+
+// VersionResponse identifies the running generator build
+type VersionResponse struct {
+	Version string `json:"version"`
+}
+
+func (s *Server) handleVersion(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(VersionResponse{Version: generator.Version})
+}
+
+// HealthResponse reports that the server is up. Generation has no external
+// dependencies, so a running process is a healthy one.
+type HealthResponse struct {
+	Status  string `json:"status"`
+	Version string `json:"version"`
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(HealthResponse{Status: "ok", Version: generator.Version})
+}
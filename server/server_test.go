@@ -0,0 +1,254 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thirukguru/go-initializer/generator"
+)
+
+// newTestServer returns a server rendering the templates of the repository,
+// without rate limiting or log output
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	s := New(embed.FS{}, embed.FS{}, os.DirFS("../templates"))
+	s.RateLimit = 0
+	s.Offline = true
+	s.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	return s
+}
+
+// post sends body to path on the server's router and returns the recorded response
+func post(t *testing.T, s *Server, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.Router().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPreviewMatchesGenerate(t *testing.T) {
+	s := newTestServer(t)
+	body := `{"project_name": "myapi", "module": "example.com/myapi", "use_docker": true}`
+
+	rec := post(t, s, "/api/generate", body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("generate: status %d: %s", rec.Code, rec.Body)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make(map[string]int)
+	for _, f := range archive.File {
+		want[strings.TrimPrefix(f.Name, "myapi/")] = int(f.UncompressedSize64)
+	}
+
+	rec = post(t, s, "/api/preview", body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("preview: status %d: %s", rec.Code, rec.Body)
+	}
+	var preview PreviewResponse
+	if err := json.NewDecoder(rec.Body).Decode(&preview); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(preview.Files) != len(want) {
+		t.Errorf("preview lists %d files, the archive has %d", len(preview.Files), len(want))
+	}
+	for _, f := range preview.Files {
+		size, ok := want[f.Path]
+		if !ok {
+			t.Errorf("preview lists %s, which is not in the archive", f.Path)
+			continue
+		}
+		if f.Size != size {
+			t.Errorf("preview size of %s is %d, archive size is %d", f.Path, f.Size, size)
+		}
+	}
+}
+
+func TestPreviewValidatesRequest(t *testing.T) {
+	s := newTestServer(t)
+	rec := post(t, s, "/api/preview", `{"project_name": "myapi", "module": "myapi"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestFileChecksSchema(t *testing.T) {
+	s := newTestServer(t)
+	rec := post(t, s, "/api/file", `{"project_name": "myapi", "module": "example.com/myapi", "port": "8080", "path": "go.mod"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if !strings.Contains(rec.Body.String(), "Invalid request: port") {
+		t.Errorf("body %s doesn't name the wrongly typed field", rec.Body)
+	}
+}
+
+func TestGenerateRejectsGRPCOutsideStandardAndFeature(t *testing.T) {
+	s := newTestServer(t)
+	for _, structure := range []string{"flat", "hexagonal", "clean"} {
+		t.Run(structure, func(t *testing.T) {
+			body := `{"project_name": "myapi", "module": "example.com/myapi", "project_type": "grpc", "structure": "` + structure + `"}`
+			rec := post(t, s, "/api/generate", body)
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+			if !strings.Contains(rec.Body.String(), "gRPC projects are only available") {
+				t.Errorf("body %s doesn't explain the rejected structure", rec.Body)
+			}
+		})
+	}
+}
+
+func TestOversizedBodyRejected(t *testing.T) {
+	s := newTestServer(t)
+	s.MaxBodyBytes = 1 << 10
+	body := `{"project_name": "myapi", "module": "example.com/myapi", "description": "` + strings.Repeat("x", 2<<10) + `"}`
+
+	for _, path := range []string{"/api/generate", "/api/preview", "/api/file", "/api/validate", "/api/share"} {
+		t.Run(path, func(t *testing.T) {
+			rec := post(t, s, path, body)
+			if rec.Code != http.StatusRequestEntityTooLarge {
+				t.Errorf("status %d, want %d: %s", rec.Code, http.StatusRequestEntityTooLarge, rec.Body)
+			}
+		})
+	}
+}
+
+func TestCORSAllowedOrigins(t *testing.T) {
+	s := newTestServer(t)
+	s.AllowedOrigins = []string{"https://app.example.com", "https://*.example.org"}
+
+	tests := []struct {
+		origin  string
+		allowed bool
+	}{
+		{"https://app.example.com", true},
+		{"https://docs.example.org", true},
+		{"https://example.com", false},
+		{"http://app.example.com", false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodOptions, "/api/generate", nil)
+		req.Header.Set("Origin", tt.origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		rec := httptest.NewRecorder()
+		s.Router().ServeHTTP(rec, req)
+
+		got := rec.Header().Get("Access-Control-Allow-Origin")
+		if allowed := got == tt.origin; allowed != tt.allowed {
+			t.Errorf("origin %s: Access-Control-Allow-Origin %q, want allowed %v", tt.origin, got, tt.allowed)
+		}
+	}
+}
+
+// slowRenderer blocks every render until its context is done
+type slowRenderer struct{}
+
+func (slowRenderer) RenderFilesContext(ctx context.Context, config generator.ProjectConfig) ([]generator.GeneratedFile, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (slowRenderer) RenderFileContext(ctx context.Context, config generator.ProjectConfig, path string) (generator.GeneratedFile, error) {
+	<-ctx.Done()
+	return generator.GeneratedFile{}, ctx.Err()
+}
+
+func TestGenerateTimeout(t *testing.T) {
+	s := newTestServer(t)
+	s.generator = slowRenderer{}
+	s.GenerateTimeout = 10 * time.Millisecond
+	body := `{"project_name": "myapi", "module": "example.com/myapi", "path": "go.mod"}`
+
+	for _, path := range []string{"/api/generate", "/api/preview", "/api/file"} {
+		t.Run(path, func(t *testing.T) {
+			rec := post(t, s, path, body)
+			if rec.Code != http.StatusGatewayTimeout {
+				t.Errorf("status %d, want %d: %s", rec.Code, http.StatusGatewayTimeout, rec.Body)
+			}
+		})
+	}
+}
+
+func TestShareValidatesRequest(t *testing.T) {
+	s := newTestServer(t)
+	rec := post(t, s, "/api/share", `{"project_name": "myapi", "module": "myapi"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if len(s.shares.configs) != 0 {
+		t.Errorf("stored %d configurations, want none", len(s.shares.configs))
+	}
+}
+
+func TestShareRateLimited(t *testing.T) {
+	s := newTestServer(t)
+	s.RateLimit = 1
+	router := s.Router()
+	body := `{"project_name": "myapi", "module": "example.com/myapi"}`
+
+	for i, want := range []int{http.StatusCreated, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodPost, "/api/share", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code != want {
+			t.Errorf("request %d: status %d, want %d", i+1, rec.Code, want)
+		}
+	}
+}
+
+func TestShareStoreFull(t *testing.T) {
+	s := newTestServer(t)
+	s.shares.limit = 1
+	body := `{"project_name": "myapi", "module": "example.com/myapi"}`
+
+	if rec := post(t, s, "/api/share", body); rec.Code != http.StatusCreated {
+		t.Fatalf("status %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+	if rec := post(t, s, "/api/share", body); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status %d, want %d: %s", rec.Code, http.StatusServiceUnavailable, rec.Body)
+	}
+}
+
+func TestShareStoreSweepsPeriodically(t *testing.T) {
+	store := newShareStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	if _, _, err := store.put(GenerateRequest{}, time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	// Within the sweep interval the expired entry stays until it is looked up
+	now = now.Add(2 * time.Second)
+	if _, _, err := store.put(GenerateRequest{}, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.configs) != 2 {
+		t.Errorf("%d configurations before the sweep, want 2", len(store.configs))
+	}
+
+	now = now.Add(shareSweepInterval)
+	if _, _, err := store.put(GenerateRequest{}, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.configs) != 2 {
+		t.Errorf("%d configurations after the sweep, want 2", len(store.configs))
+	}
+}
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// defaultShareTTL is how long a shared configuration stays retrievable
+	defaultShareTTL = 7 * 24 * time.Hour
+
+	// shareIDBytes is the number of random bytes in a share ID (hex encoded)
+	shareIDBytes = 8
+
+	// maxShares bounds how many configurations are kept at once
+	maxShares = 10000
+
+	// maxShareBytes is the largest request body POST /api/share stores;
+	// a generate request is a few hundred bytes
+	maxShareBytes = 16 << 10
+
+	// shareSweepInterval is how often expired configurations are dropped
+	shareSweepInterval = time.Minute
+
+	// configCodeVersion prefixes every encoded config so the format can change
+	// without breaking links that are already in circulation
+	configCodeVersion = "v1."
+)
+
+// sharedConfig is a stored configuration and the time it stops being served
+type sharedConfig struct {
+	req     GenerateRequest
+	expires time.Time
+}
+
+// errShareStoreFull is returned by put when the store holds limit entries
+var errShareStoreFull = errors.New("share store is full")
+
+// shareStore keeps up to limit shared configurations in memory, keyed by an
+// opaque ID. Expired entries are dropped on lookup and swept periodically
+// when a new one is added.
+type shareStore struct {
+	limit int
+
+	mu        sync.Mutex
+	configs   map[string]sharedConfig
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func newShareStore() *shareStore {
+	return &shareStore{
+		limit:   maxShares,
+		configs: make(map[string]sharedConfig),
+		now:     time.Now,
+	}
+}
+
+// put stores req for ttl and returns its ID and expiry time, or
+// errShareStoreFull when no room is left
+func (s *shareStore) put(req GenerateRequest, ttl time.Duration) (string, time.Time, error) {
+	buf := make([]byte, shareIDBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", time.Time{}, err
+	}
+	id := hex.EncodeToString(buf)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) >= shareSweepInterval {
+		for key, cfg := range s.configs {
+			if !now.Before(cfg.expires) {
+				delete(s.configs, key)
+			}
+		}
+		s.lastSweep = now
+	}
+	if len(s.configs) >= s.limit {
+		return "", time.Time{}, errShareStoreFull
+	}
+
+	expires := now.Add(ttl)
+	s.configs[id] = sharedConfig{req: req, expires: expires}
+	return id, expires, nil
+}
+
+// get returns the configuration stored under id, if it exists and has not expired
+func (s *shareStore) get(id string) (GenerateRequest, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cfg, ok := s.configs[id]
+	if !ok {
+		return GenerateRequest{}, false
+	}
+	if !s.now().Before(cfg.expires) {
+		delete(s.configs, id)
+		return GenerateRequest{}, false
+	}
+	return cfg.req, true
+}
+
+// ShareResponse is returned by POST /api/share
+type ShareResponse struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (s *Server) handleCreateShare(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, min(s.MaxBodyBytes, maxShareBytes))
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		if isBodyTooLarge(err) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, "Request body too large")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "Failed to read request")
+		return
+	}
+	// Only store what /api/generate would accept
+	req, ok := s.readGenerateRequest(w, r, body)
+	if !ok {
+		return
+	}
+
+	id, expires, err := s.shares.put(req, s.ShareTTL)
+	if errors.Is(err, errShareStoreFull) {
+		s.log(r).Warn("Share store is full", "limit", s.shares.limit)
+		writeJSONError(w, http.StatusServiceUnavailable, "Too many shared configurations, try again later")
+		return
+	}
+	if err != nil {
+		s.log(r).Error("Failed to create share ID", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "Failed to share configuration")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(ShareResponse{
+		ID:        id,
+		URL:       "/api/share/" + id,
+		ExpiresAt: expires.UTC(),
+	})
+}
+
+func (s *Server) handleGetShare(w http.ResponseWriter, r *http.Request) {
+	req, ok := s.shares.get(chi.URLParam(r, "id"))
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, "Shared configuration not found or expired")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(req)
+}
+
+// EncodeConfig packs req into a URL-safe string for the ?c= query parameter.
+// The result is a version prefix followed by the base64url-encoded JSON request.
+func EncodeConfig(req GenerateRequest) (string, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	return configCodeVersion + base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeConfig reverses EncodeConfig. Fields unknown to this version are
+// ignored and missing fields keep their zero value, so links made by older
+// or newer servers still decode.
+func DecodeConfig(s string) (GenerateRequest, error) {
+	payload, ok := strings.CutPrefix(s, configCodeVersion)
+	if !ok {
+		return GenerateRequest{}, fmt.Errorf("unsupported config encoding version")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(payload, "="))
+	if err != nil {
+		return GenerateRequest{}, fmt.Errorf("invalid base64url payload: %w", err)
+	}
+
+	var req GenerateRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return GenerateRequest{}, fmt.Errorf("invalid config payload: %w", err)
+	}
+	return req, nil
+}
+
+func (s *Server) handleDecode(w http.ResponseWriter, r *http.Request) {
+	code := r.URL.Query().Get("c")
+	if code == "" {
+		writeJSONError(w, http.StatusBadRequest, "Missing c query parameter")
+		return
+	}
+
+	req, err := DecodeConfig(code)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "Invalid config: "+err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(req)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"net/http"
+	"strings"
+)
+
+const (
+	// staticCacheControl lets browsers reuse assets for an hour; their names
+	// carry no version, so a longer lifetime would hide a new release
+	staticCacheControl = "public, max-age=3600"
+
+	// indexCacheControl keeps the page short-lived so a release shows up
+	// within minutes; the ETag makes the revalidation cheap
+	indexCacheControl = "public, max-age=300"
+)
+
+// contentETag returns a strong ETag derived from data
+func contentETag(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// staticHandler serves fsys with a content hash ETag and a Cache-Control
+// header on every file. Embedded files can't change while the server runs,
+// so the hashes are computed once up front.
+func staticHandler(fsys fs.FS) (http.Handler, error) {
+	etags := make(map[string]string)
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		etags[path] = contentETag(data)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := http.FileServer(http.FS(fsys))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// http.FileServer answers If-None-Match from the ETag header set here
+		if etag, ok := etags[strings.TrimPrefix(r.URL.Path, "/")]; ok {
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Cache-Control", staticCacheControl)
+		}
+		files.ServeHTTP(w, r)
+	}), nil
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	nodeTypeDir  = "dir"
+	nodeTypeFile = "file"
+)
+
+// PreviewTreeResponse is the body of POST /api/preview?tree=true
+type PreviewTreeResponse struct {
+	Tree []*FileNode `json:"tree"`
+}
+
+// FileNode is a directory or file of a preview tree. Path is relative to the
+// project root, so a file's path can be passed to /api/file as is.
+type FileNode struct {
+	Name      string      `json:"name"`
+	Type      string      `json:"type"`
+	Path      string      `json:"path"`
+	Size      int         `json:"size,omitempty"`
+	Content   string      `json:"content,omitempty"`
+	Truncated bool        `json:"truncated,omitempty"`
+	Children  []*FileNode `json:"children,omitempty"`
+}
+
+// buildFileTree nests previews by directory. Each level lists directories
+// before files, both sorted by name.
+func buildFileTree(previews []FilePreview) []*FileNode {
+	root := &FileNode{Type: nodeTypeDir}
+	dirs := map[string]*FileNode{"": root}
+
+	for _, preview := range previews {
+		parent := root
+		parts := strings.Split(preview.Path, "/")
+		for i, name := range parts[:len(parts)-1] {
+			dirPath := strings.Join(parts[:i+1], "/")
+			dir, ok := dirs[dirPath]
+			if !ok {
+				dir = &FileNode{Name: name, Type: nodeTypeDir, Path: dirPath}
+				dirs[dirPath] = dir
+				parent.Children = append(parent.Children, dir)
+			}
+			parent = dir
+		}
+		parent.Children = append(parent.Children, &FileNode{
+			Name:      parts[len(parts)-1],
+			Type:      nodeTypeFile,
+			Path:      preview.Path,
+			Size:      preview.Size,
+			Content:   preview.Content,
+			Truncated: preview.Truncated,
+		})
+	}
+
+	for _, dir := range dirs {
+		sort.Slice(dir.Children, func(i, j int) bool {
+			a, b := dir.Children[i], dir.Children[j]
+			if a.Type != b.Type {
+				return a.Type == nodeTypeDir
+			}
+			return a.Name < b.Name
+		})
+	}
+
+	if root.Children == nil {
+		return []*FileNode{}
+	}
+	return root.Children
+}
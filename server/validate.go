@@ -0,0 +1,335 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/thirukguru/go-initializer/generator"
+)
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+// ValidationIssue is a single problem found in a generate request. Errors
+// make /api/generate reject the request; warnings only flag settings that
+// will be ignored or are likely mistakes.
+type ValidationIssue struct {
+	Field    string `json:"field"`
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+// ValidateResponse is returned by POST /api/validate
+type ValidateResponse struct {
+	Valid  bool              `json:"valid"`
+	Issues []ValidationIssue `json:"issues"`
+}
+
+// validateRequest runs every check on req and returns the issues found, in
+// field order. Empty fields that have a default are not reported.
+func validateRequest(req GenerateRequest) []ValidationIssue {
+	var issues []ValidationIssue
+	fail := func(field, message string) {
+		issues = append(issues, ValidationIssue{Field: field, Message: message, Severity: severityError})
+	}
+	warn := func(field, message string) {
+		issues = append(issues, ValidationIssue{Field: field, Message: message, Severity: severityWarning})
+	}
+
+	// Core
+	if req.Preset != "" {
+		if _, ok := lookupPreset(req.Preset); !ok {
+			fail("preset", "Unknown preset: "+req.Preset)
+		}
+	}
+	if req.ProjectName == "" {
+		fail("project_name", "Project name is required")
+	} else if err := generator.ValidateProjectName(req.ProjectName); err != nil {
+		fail("project_name", "Invalid project name: "+err.Error())
+	}
+	if req.ArchiveRoot != nil {
+		if err := generator.ValidateArchiveRoot(*req.ArchiveRoot); err != nil {
+			fail("archive_root", "Invalid archive root: "+err.Error())
+		}
+	}
+	if req.Module == "" {
+		fail("module", "Module path is required")
+	} else if err := generator.ValidateModulePath(req.Module); err != nil {
+		fail("module", "Invalid module path: "+err.Error())
+	}
+	if req.GoVersion != "" {
+		if _, err := generator.NormalizeGoVersion(req.GoVersion); err != nil {
+			fail("go_version", "Invalid Go version: "+err.Error())
+		}
+	}
+
+	// Structure and routing
+	options := generator.GetOptions()
+	if req.Structure != "" && !slices.Contains(options.Structures, req.Structure) {
+		fail("structure", fmt.Sprintf("Unsupported structure: %s, expected one of %s", req.Structure, strings.Join(options.Structures, ", ")))
+	}
+	if req.ProjectType != "" && !slices.Contains(options.ProjectTypes, req.ProjectType) {
+		fail("project_type", "Unsupported project type: "+req.ProjectType)
+	}
+	if req.Router != "" && !slices.Contains(options.Routers, req.Router) {
+		fail("router", "Unsupported router: "+req.Router)
+	} else if req.Router != "" && (req.ProjectType == "cli" || req.ProjectType == "grpc" || req.ProjectType == "library") {
+		warn("router", fmt.Sprintf("Router %s is ignored for %s projects", req.Router, req.ProjectType))
+	}
+	if req.ProjectType == "graphql" && req.Structure != "" && req.Structure != "standard" {
+		fail("structure", "GraphQL projects are only available with the standard structure")
+	}
+	if err := generator.ValidateProjectType(req.ProjectType, req.Structure); err != nil {
+		fail("structure", "Invalid structure: "+err.Error())
+	}
+	if req.Logger != "" && !slices.Contains(options.Loggers, req.Logger) {
+		fail("logger", "Unsupported logger: "+req.Logger)
+	}
+	servesHTTP := req.ProjectType == "" || req.ProjectType == "rest-api"
+	if req.Port != 0 {
+		if err := generator.ValidatePort(req.Port); err != nil {
+			fail("port", "Invalid port: "+err.Error())
+		}
+	}
+	if req.FeatureName != "" {
+		if err := generator.ValidateFeatureName(req.FeatureName); err != nil {
+			fail("feature_name", "Invalid feature name: "+err.Error())
+		} else if req.Structure != "feature" {
+			warn("feature_name", "The feature name is only used by the feature structure")
+		} else if len(req.Features) > 0 {
+			warn("feature_name", "feature_name is ignored when features is set")
+		}
+	}
+	if len(req.Features) > 0 {
+		if err := generator.ValidateFeatures(req.Features); err != nil {
+			fail("features", "Invalid features: "+err.Error())
+		} else if req.Structure != "feature" {
+			warn("features", "Features are only used by the feature structure")
+		}
+	}
+
+	if len(req.Services) > 0 {
+		if err := generator.ValidateServices(req.Services); err != nil {
+			fail("services", "Invalid services: "+err.Error())
+		} else if !servesHTTP || (req.Structure != "" && req.Structure != "standard") {
+			warn("services", "Services are only generated for rest-api projects in the standard structure")
+		} else if req.UseKubernetes && req.UseDocker {
+			warn("use_kubernetes", "Kubernetes manifests are not generated for a monorepo of several services")
+		}
+	}
+
+	// Optional features
+	switch req.License {
+	case "", "mit", "apache-2.0", "gpl-3.0", "bsd-3-clause", "none":
+	default:
+		fail("license", "Unsupported license: "+req.License)
+	}
+	switch req.CIProvider {
+	case "", "github", "gitlab", "circleci", "none":
+	default:
+		fail("ci_provider", "Unsupported CI provider: "+req.CIProvider)
+	}
+	switch req.PlatformTarget {
+	case "", "none":
+	case "heroku", "railway":
+		switch {
+		case req.ProjectType != "rest-api" && req.ProjectType != "graphql":
+			warn("platform_target", "Deploy descriptors are only generated for REST and GraphQL services")
+		case req.Structure == "flat":
+			warn("platform_target", "Flat projects listen on a fixed port rather than the PORT the platform assigns")
+		case (req.Structure == "" || req.Structure == "standard") && !req.UseConfig:
+			warn("platform_target", "The service listens on a fixed port; enable use_config so it binds the PORT the platform assigns")
+		}
+	default:
+		fail("platform_target", "Unsupported platform target: "+req.PlatformTarget)
+	}
+	switch req.UseDependencyBot {
+	case "", "none", "renovate":
+	case "dependabot":
+		if req.CIProvider != "github" && (req.CIProvider != "" || !req.UseGitHub) {
+			warn("use_dependency_bot", "Dependabot is only configured together with the github CI provider")
+		}
+	default:
+		fail("use_dependency_bot", "Unsupported dependency bot: "+req.UseDependencyBot)
+	}
+	if req.DefaultBranch != "" {
+		if err := generator.ValidateBranchName(req.DefaultBranch); err != nil {
+			fail("default_branch", "Invalid default branch: "+err.Error())
+		}
+	}
+	switch req.ConfigStyle {
+	case "", "env":
+	case "viper":
+		standard := req.Structure == "" || req.Structure == "standard"
+		if !(standard && req.UseConfig) && req.Structure != "feature" {
+			warn("config_style", "Viper config is only generated for the standard structure with use_config and for the feature structure")
+		}
+	default:
+		fail("config_style", "Unsupported config style: "+req.ConfigStyle)
+	}
+	if req.UseDotenv && req.ProjectType == "library" {
+		warn("use_dotenv", "use_dotenv is ignored for libraries, which have no main package")
+	}
+	if req.ProjectType == "library" {
+		if req.UseDocker {
+			warn("use_docker", "use_docker is ignored for libraries, which have no binary to put in an image")
+		}
+		if req.UseAir {
+			warn("use_air", "use_air is ignored for libraries, which have no main package to reload")
+		}
+	}
+	switch req.Database {
+	case "", "postgres", "mysql", "sqlite":
+		if req.Database != "" && !req.UseDatabase {
+			warn("database", "Database "+req.Database+" is ignored unless use_database is set")
+		}
+	default:
+		fail("database", "Unsupported database: "+req.Database)
+	}
+	if req.UseSqlc && (!req.UseDatabase || (req.Database != "" && req.Database != "postgres")) {
+		warn("use_sqlc", "sqlc files are only generated together with use_database and the postgres database")
+	}
+	if req.UseSwagger && (!servesHTTP || (req.Structure != "" && req.Structure != "standard")) {
+		warn("use_swagger", "The OpenAPI spec and Swagger UI are only generated for rest-api projects in the standard structure")
+	}
+	if req.UseBuf && (req.ProjectType != "grpc" || !(req.Structure == "" || req.Structure == "standard" || req.Structure == "feature")) {
+		warn("use_buf", "buf configuration is only generated for grpc projects in the standard and feature structures")
+	}
+	if req.UseSBOM && (req.CIProvider == "none" || (req.CIProvider == "" && !req.UseGitHub)) {
+		warn("use_sbom", "The SBOM step is only generated together with a CI provider")
+	}
+	if req.UseKubernetes && !req.UseDocker {
+		warn("use_kubernetes", "Kubernetes manifests are only generated together with use_docker")
+	}
+	if req.UseJWT && !servesHTTP {
+		warn("use_jwt", "JWT middleware is only generated for rest-api projects")
+	}
+
+	// Dependencies; seen maps each package to the field that selected it first
+	seen := make(map[string]string, len(req.Dependencies))
+	for i, dep := range req.Dependencies {
+		field := fmt.Sprintf("dependencies[%d]", i)
+
+		entry, ok := lookupCatalog(options.Dependencies, dep.Pkg)
+		key := dep.Pkg
+		if ok {
+			key = entry.Package
+		}
+		first, dup := seen[key]
+		if !dup {
+			seen[key] = field
+		}
+
+		if dup {
+			warn(field+".pkg", fmt.Sprintf("%s is already selected by %s and will be dropped", dep.Pkg, first))
+		} else if !ok {
+			warn(field+".pkg", "Dependency "+dep.Pkg+" is not in the catalog and will be ignored")
+		} else if entry.Category == "WEB" && servesHTTP && routerProvides(req.Router, entry.Package) {
+			warn(field+".pkg", fmt.Sprintf("%s is already added by the %s router", entry.Name, req.Router))
+		} else if entry.Category == "WEB" && servesHTTP && req.Router != "" && !routerProvides(req.Router, entry.Package) {
+			warn(field+".pkg", fmt.Sprintf("%s is added alongside the %s router; the generated code only uses %s", entry.Name, req.Router, req.Router))
+		} else if entry.Package == "go.mongodb.org/mongo-driver" && req.UseDatabase && (req.Structure == "feature" || req.Structure == "hexagonal") {
+			warn("use_database", "MongoDB backs the repository in the "+req.Structure+" layout; the SQL database is not generated")
+		}
+
+		if dep.Version != "" {
+			if err := generator.ValidateVersion(dep.Version); err != nil {
+				fail(field+".version", "Invalid version for "+dep.Pkg+": "+err.Error())
+			}
+		}
+	}
+
+	return issues
+}
+
+// depPkgAttr matches the package each entry of the UI's dependency picker sends
+var depPkgAttr = regexp.MustCompile(`data-dep-pkg="([^"'+]+)"`)
+
+// ValidateDependencyPicker checks that every package offered by the UI's
+// dependency picker resolves to a catalog entry. An entry that doesn't would
+// be dropped from go.mod without a word when it is selected.
+func (s *Server) ValidateDependencyPicker() error {
+	data, err := s.webFiles.ReadFile("web/templates/index.html")
+	if err != nil {
+		return err
+	}
+
+	catalog := generator.GetOptions().Dependencies
+	var unknown []string
+	for _, m := range depPkgAttr.FindAllSubmatch(data, -1) {
+		pkg := string(m[1])
+		if _, ok := lookupCatalog(catalog, pkg); !ok {
+			unknown = append(unknown, pkg)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("dependencies offered by the UI but missing from the catalog: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// lookupCatalog finds a catalog entry by display name or package path
+func lookupCatalog(catalog []generator.Dependency, dep string) (generator.Dependency, bool) {
+	for _, d := range catalog {
+		if d.Name == dep || d.Package == dep {
+			return d, true
+		}
+	}
+	return generator.Dependency{}, false
+}
+
+// routerProvides reports whether pkg is the module the router option already adds
+func routerProvides(router, pkg string) bool {
+	switch router {
+	case "chi":
+		return strings.HasPrefix(pkg, "github.com/go-chi/chi")
+	case "gin":
+		return strings.HasPrefix(pkg, "github.com/gin-gonic/gin")
+	case "echo":
+		return strings.HasPrefix(pkg, "github.com/labstack/echo")
+	case "fiber":
+		return strings.HasPrefix(pkg, "github.com/gofiber/fiber")
+	}
+	return false
+}
+
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, s.MaxBodyBytes)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		if isBodyTooLarge(err) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, "Request body too large")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "Failed to read request")
+		return
+	}
+	// A body that doesn't match the schema is reported field by field
+	issues := validateSchema(s.schema, body)
+	if len(issues) == 0 {
+		var req GenerateRequest
+		if err := decodeRequest(body, &req, &req); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+		issues = validateRequest(req)
+	}
+	resp := ValidateResponse{Valid: true, Issues: []ValidationIssue{}}
+	for _, issue := range issues {
+		if issue.Severity == severityError {
+			resp.Valid = false
+		}
+		resp.Issues = append(resp.Issues, issue)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}